@@ -9,16 +9,21 @@ import (
 
 	"github.com/adaptive/adaptive-model-registry/internal/config"
 	"github.com/adaptive/adaptive-model-registry/internal/database"
+	"github.com/adaptive/adaptive-model-registry/internal/health"
 	"github.com/adaptive/adaptive-model-registry/internal/models"
+	"github.com/adaptive/adaptive-model-registry/internal/ratelimit"
 	"github.com/adaptive/adaptive-model-registry/internal/repository"
+	"github.com/adaptive/adaptive-model-registry/internal/search"
 	"github.com/adaptive/adaptive-model-registry/internal/server"
 	"github.com/adaptive/adaptive-model-registry/internal/services"
+	"github.com/adaptive/adaptive-model-registry/internal/sync"
+	"github.com/redis/go-redis/v9"
 )
 
 func main() {
 	cfg := config.MustLoad()
 
-	db := database.MustOpen(cfg.DatabaseURL)
+	db := database.MustOpenWithDriver(cfg.DatabaseDriver, cfg.DatabaseURL)
 	defer func() {
 		if err := database.Close(db); err != nil {
 			log.Printf("close database: %v", err)
@@ -36,23 +41,113 @@ func main() {
 		&models.ModelEndpointPricing{},
 		&models.ModelSupportedParameter{},
 		&models.ModelDefaultParameters{},
+		&models.ModelPricingHistory{},
+		&models.ModelEndpointPricingHistory{},
 	); err != nil {
 		log.Fatalf("auto-migrate database: %v", err)
 	}
 
 	// Initialize repositories
-	modelRepo := repository.NewModelRepository(db)
+	var modelRepo repository.ModelRepository = repository.NewModelRepository(db)
+	if cfg.CacheSize > 0 {
+		modelRepo = repository.NewCachedModelRepository(modelRepo, cfg.CacheSize, cfg.CacheTTL)
+	}
 	providerRepo := repository.NewProviderRepository(db)
 
+	// An empty SEARCH_URLS leaves the Elasticsearch index and GET /search
+	// disabled; Postgres-backed filtering on /models is unaffected.
+	var searchService *services.SearchService
+	var indexer *search.Indexer
+	if len(cfg.SearchURLs) > 0 {
+		client, err := search.NewClient(cfg.SearchURLs, cfg.SearchIndexName)
+		if err != nil {
+			log.Fatalf("init search client: %v", err)
+		}
+		if err := client.EnsureIndex(context.Background()); err != nil {
+			log.Fatalf("ensure search index: %v", err)
+		}
+
+		indexer = search.NewIndexer(client, cfg.SearchBatchSize, cfg.SearchFlushInterval)
+		modelRepo = search.NewIndexedModelRepository(modelRepo, indexer)
+		searchService = services.NewSearchService(search.NewRepository(client))
+	}
+
 	// Initialize services
-	modelService := services.NewModelService(modelRepo)
+	modelService := services.NewModelService(modelRepo, cfg.AllowedModalities)
 	providerService := services.NewProviderService(providerRepo)
 
-	srv, err := server.New(cfg, db, modelService, providerService)
+	// RATE_LIMIT_LIMIT <= 0 disables rate limiting entirely. A non-empty
+	// RATE_LIMIT_REDIS_URL selects the Redis-backed limiter so multiple
+	// replicas share one limit instead of each enforcing their own.
+	var limiter ratelimit.RateLimiter
+	var tokenBucket *ratelimit.TokenBucketLimiter
+	if cfg.RateLimitLimit > 0 {
+		if cfg.RateLimitRedisURL != "" {
+			opt, err := redis.ParseURL(cfg.RateLimitRedisURL)
+			if err != nil {
+				log.Fatalf("parse RATE_LIMIT_REDIS_URL: %v", err)
+			}
+			limiter = ratelimit.NewRedisLimiter(redis.NewClient(opt), "ratelimit")
+		} else {
+			tokenBucket = ratelimit.NewTokenBucketLimiter()
+			limiter = tokenBucket
+		}
+	}
+
+	// An empty SYNC_CONFIG_FILE (or one with no sources) leaves the admin
+	// sync subsystem disabled; the single-source IMPORT_SOURCE_URL worker
+	// below is unaffected and keeps working standalone.
+	var scheduler *sync.Scheduler
+	if len(cfg.SyncSources) > 0 {
+		sources := make([]sync.Source, 0, len(cfg.SyncSources))
+		for _, src := range cfg.SyncSources {
+			sources = append(sources, sync.Source{
+				Name:           src.Name,
+				Fetcher:        sync.NewHTTPFetcher(src.URL),
+				Importer:       services.NewImporter(src.Format),
+				Schedule:       src.Schedule,
+				Timeout:        src.Timeout,
+				MaxRetries:     src.MaxRetries,
+				InitialBackoff: src.InitialBackoff,
+			})
+		}
+		var err error
+		scheduler, err = sync.NewScheduler(modelService, sources)
+		if err != nil {
+			log.Fatalf("init sync scheduler: %v", err)
+		}
+	}
+
+	srv, err := server.New(cfg, db, modelService, providerService, searchService, limiter, scheduler)
 	if err != nil {
 		log.Fatalf("init server: %v", err)
 	}
 
+	// An empty IMPORT_SOURCE_URL leaves periodic catalog sync disabled;
+	// seeding still works via POST /models/bulk. All the background workers
+	// started below share this context so a shutdown signal stops them together.
+	workerCtx, cancelWorkers := context.WithCancel(context.Background())
+	defer cancelWorkers()
+	if cfg.ImportSourceURL != "" {
+		worker := services.NewSyncWorker(services.NewImporter(cfg.ImportFormat), modelService, cfg.ImportSourceURL, cfg.ImportInterval)
+		go worker.Run(workerCtx)
+	}
+
+	healthScheduler := health.NewScheduler(modelService, health.NewRegistry(), cfg.HealthCheckInterval)
+	go healthScheduler.Run(workerCtx)
+
+	if indexer != nil {
+		go indexer.Run(workerCtx)
+	}
+
+	if tokenBucket != nil {
+		go tokenBucket.Run(workerCtx)
+	}
+
+	if scheduler != nil {
+		go scheduler.Run(workerCtx)
+	}
+
 	errCh := make(chan error, 1)
 	go func() {
 		if err := srv.Listen(); err != nil {
@@ -71,6 +166,7 @@ func main() {
 		}
 	case sig := <-sigCh:
 		log.Printf("received signal %s, initiating shutdown", sig)
+		cancelWorkers()
 		shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
 		defer cancel()
 		if err := srv.Shutdown(shutdownCtx); err != nil {
@@ -0,0 +1,36 @@
+// Command mirc generates Fiber route registration glue from mir-tagged
+// struct fields. It is invoked via `go generate` from internal/api.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/adaptive/adaptive-model-registry/internal/mirc"
+)
+
+func main() {
+	in := flag.String("in", "", "source file containing mir-tagged structs")
+	out := flag.String("out", "", "destination file for generated route registration")
+	pkg := flag.String("pkg", "", "package name for the generated file")
+	flag.Parse()
+
+	if *in == "" || *out == "" || *pkg == "" {
+		log.Fatal("mirc: -in, -out, and -pkg are required")
+	}
+
+	routes, err := mirc.ParseFile(*in)
+	if err != nil {
+		log.Fatalf("mirc: %v", err)
+	}
+
+	src, err := mirc.Generate(*pkg, routes)
+	if err != nil {
+		log.Fatalf("mirc: generate: %v", err)
+	}
+
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatalf("mirc: write %s: %v", *out, err)
+	}
+}
@@ -0,0 +1,30 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/adaptive/adaptive-model-registry/internal/services"
+)
+
+// DebugHandler exposes internal diagnostics that aren't part of the
+// public API contract.
+type DebugHandler struct {
+	models *services.ModelService
+}
+
+// NewDebugHandler constructs a DebugHandler.
+func NewDebugHandler(models *services.ModelService) *DebugHandler {
+	return &DebugHandler{models: models}
+}
+
+// CacheStats reports hit/miss/evict counters for the model cache, or
+// {"enabled": false} when caching is disabled.
+func (h *DebugHandler) CacheStats(c *fiber.Ctx) error {
+	stats, ok := h.models.CacheStats()
+	if !ok {
+		return successResponse(c, http.StatusOK, fiber.Map{"enabled": false})
+	}
+	return successResponse(c, http.StatusOK, stats)
+}
@@ -0,0 +1,46 @@
+// Package graph exposes a GraphQL query surface over the same provider,
+// model, and endpoint data the REST handlers in internal/api serve,
+// letting a client fetch a provider with its endpoints (and each
+// endpoint's pricing) in a single request instead of chaining several
+// REST calls.
+//
+// Deliberate deviation: the originating request called for 99designs/
+// gqlgen specifically, for schema-first, generate-time-checked resolvers
+// - the same generated-code approach internal/api's own mir.go/mirc
+// (go:generate go run ../../cmd/mirc ...) already uses for REST routing.
+// This package is built directly on graphql-go/graphql instead, with the
+// schema and resolvers hand-written and wired imperatively (string-keyed
+// args, manual stringArg/intArg/boolArg casts) rather than generated. The
+// reason is environmental, not a judgment that graphql-go is the better
+// fit: gqlgen's codegen step is a `go run` invocation against a go.mod
+// this checkout doesn't have, the same constraint that makes mirc's own
+// routes_gen.go hand-maintained here instead of regenerated. Resolvers
+// never touch the database directly; they call into the same
+// services.ModelService / services.ProviderService the REST handlers
+// use, so business logic (caching, soft-delete semantics, etc.) lives in
+// exactly one place - but a future migration to gqlgen's schema-first,
+// type-checked resolvers should be considered once codegen can run.
+package graph
+
+import (
+	"github.com/graphql-go/graphql"
+
+	"github.com/adaptive/adaptive-model-registry/internal/services"
+)
+
+// Deps groups the dependencies resolvers need.
+type Deps struct {
+	Models    *services.ModelService
+	Providers *services.ProviderService
+	// MaxLimit caps the "first" argument on every root query, mirroring
+	// Config.ListMaxLimit's role for the REST list endpoints.
+	MaxLimit int
+}
+
+// NewSchema builds the executable GraphQL schema backed by deps.
+func NewSchema(deps Deps) (graphql.Schema, error) {
+	r := &resolver{deps: deps}
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query: r.queryType(),
+	})
+}
@@ -0,0 +1,74 @@
+package graph
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/graphql-go/graphql"
+)
+
+// requestBody is the standard GraphQL-over-HTTP POST payload.
+type requestBody struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Handler serves POST /graphql: it executes body.Query against schema and
+// responds 200 with {data, errors}, per the GraphQL-over-HTTP
+// convention of reporting execution errors inside the body rather than
+// via the HTTP status. A body that fails to parse, or carries no query,
+// is rejected with 400 before anything is executed.
+func Handler(schema graphql.Schema) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var body requestBody
+		if err := c.BodyParser(&body); err != nil {
+			return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+				"errors": []fiber.Map{{"message": err.Error()}},
+			})
+		}
+		if body.Query == "" {
+			return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+				"errors": []fiber.Map{{"message": "query is required"}},
+			})
+		}
+
+		result := graphql.Do(graphql.Params{
+			Schema:         schema,
+			RequestString:  body.Query,
+			OperationName:  body.OperationName,
+			VariableValues: body.Variables,
+			Context:        c.UserContext(),
+		})
+
+		return c.Status(http.StatusOK).JSON(result)
+	}
+}
+
+// PlaygroundHandler serves a GraphQL Playground page (loaded from the
+// public CDN build) pointed at endpoint, for exploring the schema
+// interactively without a separate client.
+func PlaygroundHandler(endpoint string) fiber.Handler {
+	page := `<!DOCTYPE html>
+<html>
+<head>
+	<meta charset="utf-8" />
+	<title>Adaptive Model Registry - GraphQL Playground</title>
+	<link rel="stylesheet" href="https://cdn.jsdelivr.net/npm/graphql-playground-react/build/static/css/index.css" />
+	<script src="https://cdn.jsdelivr.net/npm/graphql-playground-react/build/static/js/middleware.js"></script>
+</head>
+<body>
+	<div id="root"></div>
+	<script>
+		window.addEventListener('load', function () {
+			GraphQLPlayground.init(document.getElementById('root'), { endpoint: '` + endpoint + `' })
+		})
+	</script>
+</body>
+</html>`
+
+	return func(c *fiber.Ctx) error {
+		c.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+		return c.Status(http.StatusOK).SendString(page)
+	}
+}
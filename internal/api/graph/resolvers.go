@@ -0,0 +1,441 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/adaptive/adaptive-model-registry/internal/models"
+	"github.com/adaptive/adaptive-model-registry/internal/repository"
+)
+
+const defaultFirst = 50
+
+// normalizeFirst clamps the GraphQL "first" argument the same way
+// api.normalizeLimit clamps REST's "limit" query param.
+func normalizeFirst(requested, max int) int {
+	if max > 0 && requested > max {
+		return max
+	}
+	if requested <= 0 || requested > max {
+		if max > 0 && defaultFirst > max {
+			return max
+		}
+		return defaultFirst
+	}
+	return requested
+}
+
+func requestContext(p graphql.ResolveParams) context.Context {
+	if p.Context != nil {
+		return p.Context
+	}
+	return context.Background()
+}
+
+func stringArg(args map[string]interface{}, key string) *string {
+	v, ok := args[key]
+	if !ok || v == nil {
+		return nil
+	}
+	s, _ := v.(string)
+	return &s
+}
+
+func intArg(args map[string]interface{}, key string) *int {
+	v, ok := args[key]
+	if !ok || v == nil {
+		return nil
+	}
+	i, _ := v.(int)
+	return &i
+}
+
+func boolArg(args map[string]interface{}, key string) *bool {
+	v, ok := args[key]
+	if !ok || v == nil {
+		return nil
+	}
+	b, _ := v.(bool)
+	return &b
+}
+
+func stringSliceArg(args map[string]interface{}, key string) []string {
+	v, ok := args[key]
+	if !ok || v == nil {
+		return nil
+	}
+	raw, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+	out := make([]string, 0, len(raw))
+	for _, item := range raw {
+		if s, ok := item.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func (r *resolver) providerFilter() *graphql.InputObject {
+	if r.providerFilterInput != nil {
+		return r.providerFilterInput
+	}
+	r.providerFilterInput = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "ProviderFilterInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"tags":                 &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.String)},
+			"status":               &graphql.InputObjectFieldConfig{Type: graphql.Int},
+			"inputModalities":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.String)},
+			"outputModalities":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.String)},
+			"minContextLength":     &graphql.InputObjectFieldConfig{Type: graphql.Int},
+			"hasPricing":           &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+			"quantizations":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.String)},
+			"maxPromptCost":        &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"maxCompletionCost":    &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"supportedParams":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.String)},
+			"healthyWithinMinutes": &graphql.InputObjectFieldConfig{Type: graphql.Int},
+		},
+	})
+	return r.providerFilterInput
+}
+
+func toProviderFilter(args map[string]interface{}) models.ProviderFilter {
+	return models.ProviderFilter{
+		Tags:                 stringSliceArg(args, "tags"),
+		Status:               intArg(args, "status"),
+		InputModalities:      stringSliceArg(args, "inputModalities"),
+		OutputModalities:     stringSliceArg(args, "outputModalities"),
+		MinContextLength:     intArg(args, "minContextLength"),
+		HasPricing:           boolArg(args, "hasPricing"),
+		Quantizations:        stringSliceArg(args, "quantizations"),
+		MaxPromptCost:        stringArg(args, "maxPromptCost"),
+		MaxCompletionCost:    stringArg(args, "maxCompletionCost"),
+		SupportedParams:      stringSliceArg(args, "supportedParams"),
+		HealthyWithinMinutes: intArg(args, "healthyWithinMinutes"),
+	}
+}
+
+func (r *resolver) modelFilter() *graphql.InputObject {
+	if r.modelFilterInput != nil {
+		return r.modelFilterInput
+	}
+	r.modelFilterInput = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "ModelFilterInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"authors":              &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.String)},
+			"modelNames":           &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.String)},
+			"endpointTags":         &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.String)},
+			"providers":            &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.String)},
+			"inputModalities":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.String)},
+			"outputModalities":     &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.String)},
+			"minContextLength":     &graphql.InputObjectFieldConfig{Type: graphql.Int},
+			"maxPromptCost":        &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"maxCompletionCost":    &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"supportedParams":      &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.String)},
+			"status":               &graphql.InputObjectFieldConfig{Type: graphql.Int},
+			"quantizations":        &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.String)},
+			"healthyWithinMinutes": &graphql.InputObjectFieldConfig{Type: graphql.Int},
+		},
+	})
+	return r.modelFilterInput
+}
+
+func toModelFilter(args map[string]interface{}) models.ModelFilter {
+	return models.ModelFilter{
+		Authors:              stringSliceArg(args, "authors"),
+		ModelNames:           stringSliceArg(args, "modelNames"),
+		EndpointTags:         stringSliceArg(args, "endpointTags"),
+		Providers:            stringSliceArg(args, "providers"),
+		InputModalities:      stringSliceArg(args, "inputModalities"),
+		OutputModalities:     stringSliceArg(args, "outputModalities"),
+		MinContextLength:     intArg(args, "minContextLength"),
+		MaxPromptCost:        stringArg(args, "maxPromptCost"),
+		MaxCompletionCost:    stringArg(args, "maxCompletionCost"),
+		SupportedParams:      stringSliceArg(args, "supportedParams"),
+		Status:               intArg(args, "status"),
+		Quantizations:        stringSliceArg(args, "quantizations"),
+		HealthyWithinMinutes: intArg(args, "healthyWithinMinutes"),
+	}
+}
+
+// paginationArgs is the first/after pair every root query accepts.
+var paginationArgs = graphql.FieldConfigArgument{
+	"first": &graphql.ArgumentConfig{Type: graphql.Int},
+	"after": &graphql.ArgumentConfig{Type: graphql.String},
+}
+
+func (r *resolver) providerConnection() *graphql.Object {
+	edge := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ProviderEdge",
+		Fields: graphql.Fields{
+			"cursor": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"node":   &graphql.Field{Type: r.provider()},
+		},
+	})
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "ProviderConnection",
+		Fields: graphql.Fields{
+			"edges":    &graphql.Field{Type: graphql.NewList(edge)},
+			"pageInfo": &graphql.Field{Type: r.pageInfo()},
+		},
+	})
+}
+
+func (r *resolver) modelConnection() *graphql.Object {
+	edge := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ModelEdge",
+		Fields: graphql.Fields{
+			"cursor": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"node":   &graphql.Field{Type: r.model()},
+		},
+	})
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "ModelConnection",
+		Fields: graphql.Fields{
+			"edges":    &graphql.Field{Type: graphql.NewList(edge)},
+			"pageInfo": &graphql.Field{Type: r.pageInfo()},
+		},
+	})
+}
+
+func (r *resolver) endpointConnection() *graphql.Object {
+	edge := graphql.NewObject(graphql.ObjectConfig{
+		Name: "EndpointEdge",
+		Fields: graphql.Fields{
+			"cursor": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"node":   &graphql.Field{Type: r.endpoint()},
+		},
+	})
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "EndpointConnection",
+		Fields: graphql.Fields{
+			"edges":    &graphql.Field{Type: graphql.NewList(edge)},
+			"pageInfo": &graphql.Field{Type: r.pageInfo()},
+		},
+	})
+}
+
+func (r *resolver) queryType() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"providers": &graphql.Field{
+				Type: r.providerConnection(),
+				Args: mergeArgs(paginationArgs, graphql.FieldConfigArgument{
+					"filter": &graphql.ArgumentConfig{Type: r.providerFilter()},
+				}),
+				Resolve: r.resolveProviders,
+			},
+			"models": &graphql.Field{
+				Type: r.modelConnection(),
+				Args: mergeArgs(paginationArgs, graphql.FieldConfigArgument{
+					"filter": &graphql.ArgumentConfig{Type: r.modelFilter()},
+				}),
+				Resolve: r.resolveModels,
+			},
+			"endpoints": &graphql.Field{
+				Type: r.endpointConnection(),
+				Args: mergeArgs(paginationArgs, graphql.FieldConfigArgument{
+					"filter": &graphql.ArgumentConfig{Type: r.modelFilter()},
+				}),
+				Resolve: r.resolveEndpoints,
+			},
+		},
+	})
+}
+
+func mergeArgs(base graphql.FieldConfigArgument, extra graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+	merged := make(graphql.FieldConfigArgument, len(base)+len(extra))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range extra {
+		merged[k] = v
+	}
+	return merged
+}
+
+func (r *resolver) resolveProviders(p graphql.ResolveParams) (interface{}, error) {
+	filter := models.ProviderFilter{}
+	if raw, ok := p.Args["filter"].(map[string]interface{}); ok {
+		filter = toProviderFilter(raw)
+	}
+
+	var cursor string
+	if after, ok := p.Args["after"].(string); ok && after != "" {
+		decoded, err := repository.DecodeProviderCursor(after)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor: %w", err)
+		}
+		cursor = decoded
+	}
+
+	first, _ := p.Args["first"].(int)
+	limit := normalizeFirst(first, r.deps.MaxLimit)
+
+	page, err := r.deps.Providers.ListPage(requestContext(p), filter, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]map[string]interface{}, len(page))
+	for i, item := range page {
+		edges[i] = map[string]interface{}{
+			"cursor": repository.EncodeProviderCursor(item.Name),
+			"node":   item,
+		}
+	}
+
+	pageInfo := map[string]interface{}{"hasNextPage": len(page) == limit}
+	if len(page) > 0 {
+		pageInfo["endCursor"] = repository.EncodeProviderCursor(page[len(page)-1].Name)
+	}
+
+	return map[string]interface{}{"edges": edges, "pageInfo": pageInfo}, nil
+}
+
+func (r *resolver) resolveModels(p graphql.ResolveParams) (interface{}, error) {
+	filter := models.ModelFilter{}
+	if raw, ok := p.Args["filter"].(map[string]interface{}); ok {
+		filter = toModelFilter(raw)
+	}
+
+	var cursor *repository.StreamCursor
+	if after, ok := p.Args["after"].(string); ok && after != "" {
+		decoded, err := repository.DecodeStreamCursor(after)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor: %w", err)
+		}
+		cursor = &decoded
+	}
+
+	first, _ := p.Args["first"].(int)
+	limit := normalizeFirst(first, r.deps.MaxLimit)
+
+	page, err := r.deps.Models.ListPage(requestContext(p), filter, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]map[string]interface{}, len(page))
+	for i, item := range page {
+		edges[i] = map[string]interface{}{
+			"cursor": repository.EncodeStreamCursor(repository.StreamCursor{LastModelName: item.ModelName, LastID: item.ID}),
+			"node":   item,
+		}
+	}
+
+	pageInfo := map[string]interface{}{"hasNextPage": len(page) == limit}
+	if len(page) > 0 {
+		last := page[len(page)-1]
+		pageInfo["endCursor"] = repository.EncodeStreamCursor(repository.StreamCursor{LastModelName: last.ModelName, LastID: last.ID})
+	}
+
+	return map[string]interface{}{"edges": edges, "pageInfo": pageInfo}, nil
+}
+
+// resolveEndpoints flattens every endpoint across the models matching
+// filter. There's no repository-level paginated endpoint listing to
+// delegate to, so this paginates in memory over models.List's result the
+// same way ModelService.Check previews an Upsert against already-loaded
+// data - acceptable for the catalog sizes this registry serves, but a
+// candidate for a dedicated repository method if that stops being true.
+func (r *resolver) resolveEndpoints(p graphql.ResolveParams) (interface{}, error) {
+	filter := models.ModelFilter{}
+	if raw, ok := p.Args["filter"].(map[string]interface{}); ok {
+		filter = toModelFilter(raw)
+	}
+
+	items, err := r.deps.Models.List(requestContext(p), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var all []models.ModelEndpoint
+	for _, m := range items {
+		all = append(all, m.Providers...)
+	}
+
+	start := 0
+	if after, ok := p.Args["after"].(string); ok && after != "" {
+		afterID, err := repository.DecodeProviderCursor(after)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor: %w", err)
+		}
+		found := false
+		for i, e := range all {
+			if fmt.Sprintf("%d", e.ID) == afterID {
+				start = i + 1
+				found = true
+				break
+			}
+		}
+		// all is re-queried from scratch on every call (see comment above),
+		// so a cursor from a previous page can legitimately no longer
+		// appear (the endpoint it pointed at was deleted, or filters
+		// changed). Treat that as "nothing further to return" rather than
+		// silently restarting from the first page.
+		if !found {
+			return map[string]interface{}{
+				"edges":    []map[string]interface{}{},
+				"pageInfo": map[string]interface{}{"hasNextPage": false},
+			}, nil
+		}
+	}
+
+	first, _ := p.Args["first"].(int)
+	limit := normalizeFirst(first, r.deps.MaxLimit)
+
+	end := start + limit
+	if end > len(all) {
+		end = len(all)
+	}
+	if start > len(all) {
+		start = len(all)
+	}
+	page := all[start:end]
+
+	edges := make([]map[string]interface{}, len(page))
+	for i, item := range page {
+		edges[i] = map[string]interface{}{
+			"cursor": repository.EncodeProviderCursor(fmt.Sprintf("%d", item.ID)),
+			"node":   item,
+		}
+	}
+
+	pageInfo := map[string]interface{}{"hasNextPage": end < len(all)}
+	if len(page) > 0 {
+		pageInfo["endCursor"] = edges[len(edges)-1]["cursor"]
+	}
+
+	return map[string]interface{}{"edges": edges, "pageInfo": pageInfo}, nil
+}
+
+// resolveProviderEndpoints implements Provider.endpoints: the aggregated
+// Provider type has no endpoints column of its own, so this re-queries
+// models scoped to the provider's name and flattens their endpoints.
+func (r *resolver) resolveProviderEndpoints(p graphql.ResolveParams) (interface{}, error) {
+	provider, ok := p.Source.(models.Provider)
+	if !ok {
+		return nil, errors.New("unexpected source type for Provider.endpoints")
+	}
+
+	items, err := r.deps.Models.List(requestContext(p), models.ModelFilter{Providers: []string{provider.Name}})
+	if err != nil {
+		return nil, err
+	}
+
+	var endpoints []models.ModelEndpoint
+	for _, m := range items {
+		for _, e := range m.Providers {
+			if e.ProviderName == provider.Name {
+				endpoints = append(endpoints, e)
+			}
+		}
+	}
+	return endpoints, nil
+}
@@ -0,0 +1,370 @@
+package graph
+
+import (
+	"time"
+
+	"github.com/graphql-go/graphql"
+
+	"github.com/adaptive/adaptive-model-registry/internal/models"
+)
+
+// resolver holds the object type graph and the dependencies its field
+// resolvers close over. Types are built lazily (on first use within a
+// single NewSchema call) so fields that reference each other - e.g.
+// Model.endpoints -> Endpoint, Endpoint back to nothing cyclic here -
+// can be declared in any order.
+type resolver struct {
+	deps Deps
+
+	pageInfoType        *graphql.Object
+	modalityType        *graphql.Object
+	architectureType    *graphql.Object
+	topProviderType     *graphql.Object
+	modelPricingType    *graphql.Object
+	endpointPricingType *graphql.Object
+	endpointType        *graphql.Object
+	modelType           *graphql.Object
+	providerType        *graphql.Object
+
+	providerFilterInput *graphql.InputObject
+	modelFilterInput    *graphql.InputObject
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func (r *resolver) pageInfo() *graphql.Object {
+	if r.pageInfoType != nil {
+		return r.pageInfoType
+	}
+	r.pageInfoType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "PageInfo",
+		Fields: graphql.Fields{
+			"hasNextPage": &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+			"endCursor":   &graphql.Field{Type: graphql.String},
+		},
+	})
+	return r.pageInfoType
+}
+
+func (r *resolver) modality() *graphql.Object {
+	if r.modalityType != nil {
+		return r.modalityType
+	}
+	r.modalityType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Modality",
+		Fields: graphql.Fields{
+			"type": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelArchitectureModality).ModalityType, nil
+			}},
+			"value": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelArchitectureModality).ModalityValue, nil
+			}},
+		},
+	})
+	return r.modalityType
+}
+
+func (r *resolver) architecture() *graphql.Object {
+	if r.architectureType != nil {
+		return r.architectureType
+	}
+	r.architectureType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Architecture",
+		Fields: graphql.Fields{
+			"modality": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelArchitecture).Modality, nil
+			}},
+			"tokenizer": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelArchitecture).Tokenizer, nil
+			}},
+			"instructType": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelArchitecture).InstructType, nil
+			}},
+			"modalities": &graphql.Field{
+				Type: graphql.NewList(r.modality()),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(models.ModelArchitecture).Modalities, nil
+				},
+			},
+		},
+	})
+	return r.architectureType
+}
+
+func (r *resolver) topProvider() *graphql.Object {
+	if r.topProviderType != nil {
+		return r.topProviderType
+	}
+	r.topProviderType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "TopProvider",
+		Fields: graphql.Fields{
+			"contextLength": &graphql.Field{Type: graphql.Int, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelTopProvider).ContextLength, nil
+			}},
+			"maxCompletionTokens": &graphql.Field{Type: graphql.Int, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelTopProvider).MaxCompletionTokens, nil
+			}},
+			"isModerated": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelTopProvider).IsModerated, nil
+			}},
+		},
+	})
+	return r.topProviderType
+}
+
+func (r *resolver) modelPricing() *graphql.Object {
+	if r.modelPricingType != nil {
+		return r.modelPricingType
+	}
+	r.modelPricingType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "ModelPricing",
+		Fields: graphql.Fields{
+			"promptCost": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelPricing).PromptCost, nil
+			}},
+			"completionCost": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelPricing).CompletionCost, nil
+			}},
+			"requestCost": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelPricing).RequestCost, nil
+			}},
+			"imageCost": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelPricing).ImageCost, nil
+			}},
+			"webSearchCost": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelPricing).WebSearchCost, nil
+			}},
+			"internalReasoningCost": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelPricing).InternalReasoningCost, nil
+			}},
+		},
+	})
+	return r.modelPricingType
+}
+
+func (r *resolver) endpointPricing() *graphql.Object {
+	if r.endpointPricingType != nil {
+		return r.endpointPricingType
+	}
+	r.endpointPricingType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "EndpointPricing",
+		Fields: graphql.Fields{
+			"promptCost": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelEndpointPricing).PromptCost, nil
+			}},
+			"completionCost": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelEndpointPricing).CompletionCost, nil
+			}},
+			"requestCost": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelEndpointPricing).RequestCost, nil
+			}},
+			"imageCost": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelEndpointPricing).ImageCost, nil
+			}},
+			"imageOutputCost": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelEndpointPricing).ImageOutputCost, nil
+			}},
+			"audioCost": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelEndpointPricing).AudioCost, nil
+			}},
+			"inputAudioCacheCost": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelEndpointPricing).InputAudioCacheCost, nil
+			}},
+			"inputCacheReadCost": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelEndpointPricing).InputCacheReadCost, nil
+			}},
+			"inputCacheWriteCost": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelEndpointPricing).InputCacheWriteCost, nil
+			}},
+			"discount": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelEndpointPricing).Discount, nil
+			}},
+		},
+	})
+	return r.endpointPricingType
+}
+
+func (r *resolver) endpoint() *graphql.Object {
+	if r.endpointType != nil {
+		return r.endpointType
+	}
+	r.endpointType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Endpoint",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelEndpoint).Name, nil
+			}},
+			"endpointModelName": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelEndpoint).EndpointModelName, nil
+			}},
+			"contextLength": &graphql.Field{Type: graphql.Int, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelEndpoint).ContextLength, nil
+			}},
+			"providerName": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelEndpoint).ProviderName, nil
+			}},
+			"tag": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelEndpoint).Tag, nil
+			}},
+			"quantization": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelEndpoint).Quantization, nil
+			}},
+			"maxCompletionTokens": &graphql.Field{Type: graphql.Int, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelEndpoint).MaxCompletionTokens, nil
+			}},
+			"maxPromptTokens": &graphql.Field{Type: graphql.Int, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelEndpoint).MaxPromptTokens, nil
+			}},
+			"status": &graphql.Field{Type: graphql.NewNonNull(graphql.Int), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelEndpoint).Status, nil
+			}},
+			"uptimeLast30m": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelEndpoint).UptimeLast30m, nil
+			}},
+			"supportsImplicitCaching": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelEndpoint).SupportsImplicitCaching, nil
+			}},
+			"isZdr": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.ModelEndpoint).IsZDR, nil
+			}},
+			"lastCheckedAt": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				at := p.Source.(models.ModelEndpoint).LastCheckedAt
+				if at == nil {
+					return nil, nil
+				}
+				return formatTime(*at), nil
+			}},
+			"message": &graphql.Field{Type: graphql.String},
+			"pricing": &graphql.Field{
+				Type: r.endpointPricing(),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					pricing := p.Source.(models.ModelEndpoint).Pricing
+					if pricing == nil {
+						return nil, nil
+					}
+					return *pricing, nil
+				},
+			},
+		},
+	})
+	return r.endpointType
+}
+
+func (r *resolver) model() *graphql.Object {
+	if r.modelType != nil {
+		return r.modelType
+	}
+	r.modelType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Model",
+		Fields: graphql.Fields{
+			"author": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.Model).Author, nil
+			}},
+			"modelName": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.Model).ModelName, nil
+			}},
+			"displayName": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.Model).DisplayName, nil
+			}},
+			"description": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.Model).Description, nil
+			}},
+			"contextLength": &graphql.Field{Type: graphql.Int, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.Model).ContextLength, nil
+			}},
+			"createdAt": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return formatTime(p.Source.(models.Model).CreatedAt), nil
+			}},
+			"lastUpdated": &graphql.Field{Type: graphql.String, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return formatTime(p.Source.(models.Model).LastUpdated), nil
+			}},
+			"pricing": &graphql.Field{
+				Type: r.modelPricing(),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					pricing := p.Source.(models.Model).Pricing
+					if pricing == nil {
+						return nil, nil
+					}
+					return *pricing, nil
+				},
+			},
+			"architecture": &graphql.Field{
+				Type: r.architecture(),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					arch := p.Source.(models.Model).Architecture
+					if arch == nil {
+						return nil, nil
+					}
+					return *arch, nil
+				},
+			},
+			"topProvider": &graphql.Field{
+				Type: r.topProvider(),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					tp := p.Source.(models.Model).TopProvider
+					if tp == nil {
+						return nil, nil
+					}
+					return *tp, nil
+				},
+			},
+			"supportedParameters": &graphql.Field{
+				Type: graphql.NewList(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					params := p.Source.(models.Model).SupportedParameters
+					names := make([]string, len(params))
+					for i, sp := range params {
+						names[i] = string(sp.ParameterName)
+					}
+					return names, nil
+				},
+			},
+			"endpoints": &graphql.Field{
+				Type: graphql.NewList(r.endpoint()),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(models.Model).Providers, nil
+				},
+			},
+		},
+	})
+	return r.modelType
+}
+
+func (r *resolver) provider() *graphql.Object {
+	if r.providerType != nil {
+		return r.providerType
+	}
+	r.providerType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Provider",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.Provider).Name, nil
+			}},
+			"tags": &graphql.Field{Type: graphql.NewList(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.Provider).Tags, nil
+			}},
+			"modelCount": &graphql.Field{Type: graphql.Int, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.Provider).ModelCount, nil
+			}},
+			"endpointCount": &graphql.Field{Type: graphql.Int, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.Provider).EndpointCount, nil
+			}},
+			"activeCount": &graphql.Field{Type: graphql.Int, Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.Provider).ActiveCount, nil
+			}},
+			"quantizations": &graphql.Field{Type: graphql.NewList(graphql.String), Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return p.Source.(models.Provider).Quantizations, nil
+			}},
+			"endpoints": &graphql.Field{
+				Type:    graphql.NewList(r.endpoint()),
+				Resolve: r.resolveProviderEndpoints,
+			},
+		},
+	})
+	return r.providerType
+}
@@ -9,19 +9,21 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/adaptive/adaptive-model-registry/internal/database"
+	"github.com/adaptive/adaptive-model-registry/internal/services"
 )
 
 // HealthHandler exposes health check endpoints.
 type HealthHandler struct {
-	db *gorm.DB
+	db     *gorm.DB
+	models *services.ModelService
 }
 
 // NewHealthHandler constructs a HealthHandler.
-func NewHealthHandler(db *gorm.DB) *HealthHandler {
-	return &HealthHandler{db: db}
+func NewHealthHandler(db *gorm.DB, models *services.ModelService) *HealthHandler {
+	return &HealthHandler{db: db, models: models}
 }
 
-// Check reports database health.
+// Check reports database health plus a cache sub-check.
 func (h *HealthHandler) Check(c *fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(requestContext(c), 2*time.Second)
 	defer cancel()
@@ -30,5 +32,10 @@ func (h *HealthHandler) Check(c *fiber.Ctx) error {
 		return errorResponse(c, http.StatusServiceUnavailable, err.Error())
 	}
 
-	return c.SendStatus(http.StatusOK)
+	body := fiber.Map{"database": "ok"}
+	if stats, ok := h.models.CacheStats(); ok {
+		body["cache"] = stats
+	}
+
+	return successResponse(c, http.StatusOK, body)
 }
@@ -0,0 +1,36 @@
+package api
+
+import "net/http"
+
+// ModelsAPI declares the mir-routed endpoints served by ModelHandler.
+// internal/mirc parses this file's struct tags via go/ast to generate
+// routes_gen.go; field names must match exported ModelHandler methods.
+// The http.HandlerFunc type only exists to carry the `mir` tag — these
+// fields are never assigned or read at runtime.
+type ModelsAPI struct {
+	List                 http.HandlerFunc `mir:"GET /models"`
+	GetByProviderAndName http.HandlerFunc `mir:"GET /models/:provider/:name"`
+	Upsert               http.HandlerFunc `mir:"POST /models"`
+	Check                http.HandlerFunc `mir:"POST /models/check"`
+	BulkImport           http.HandlerFunc `mir:"POST /models/bulk"`
+	Export               http.HandlerFunc `mir:"GET /models/export"`
+	Delete               http.HandlerFunc `mir:"DELETE /models/:provider/:name"`
+	Restore              http.HandlerFunc `mir:"POST /models/:provider/:name/restore"`
+	History              http.HandlerFunc `mir:"GET /models/:provider/:name/history"`
+	Health               http.HandlerFunc `mir:"GET /models/:provider/:name/health"`
+}
+
+// ProvidersAPI declares the mir-routed endpoints served by ProviderHandler.
+type ProvidersAPI struct {
+	List http.HandlerFunc `mir:"GET /providers"`
+}
+
+// HealthAPI declares the mir-routed endpoints served by HealthHandler.
+type HealthAPI struct {
+	Check http.HandlerFunc `mir:"GET /health"`
+}
+
+// DebugAPI declares the mir-routed endpoints served by DebugHandler.
+type DebugAPI struct {
+	CacheStats http.HandlerFunc `mir:"GET /debug/cache/stats"`
+}
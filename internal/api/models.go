@@ -1,55 +1,152 @@
 package api
 
 import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 
+	"github.com/adaptive/adaptive-model-registry/internal/mirc"
 	"github.com/adaptive/adaptive-model-registry/internal/models"
 	"github.com/adaptive/adaptive-model-registry/internal/repository"
 	"github.com/adaptive/adaptive-model-registry/internal/services"
 )
 
+const (
+	defaultStreamLimit = 100
+	maxBulkLineSize    = 1 << 20 // 1 MiB; generous for a single model record
+)
+
 // ModelHandler exposes CRUD operations for models.
 type ModelHandler struct {
-	service *services.ModelService
+	service  *services.ModelService
+	maxLimit int
+}
+
+// NewModelHandler constructs a ModelHandler. maxLimit caps ?limit= on the
+// paginated List/streamList endpoints.
+func NewModelHandler(service *services.ModelService, maxLimit int) *ModelHandler {
+	return &ModelHandler{service: service, maxLimit: maxLimit}
 }
 
-// NewModelHandler constructs a ModelHandler.
-func NewModelHandler(service *services.ModelService) *ModelHandler {
-	return &ModelHandler{service: service}
+// listEnvelope is the response body for a paginated GET /models page: the
+// page of matching models, plus the cursor to pass as ?cursor= for the
+// next one (empty once there isn't one).
+type listEnvelope struct {
+	Items      []models.Model `json:"items"`
+	NextCursor string         `json:"next_cursor,omitempty"`
 }
 
-// List returns all registered models.
+// List returns one page of registered models as a listEnvelope, bounded by
+// ?limit= (default 100, capped server-side) and advanced via ?cursor= (from
+// the previous page's next_cursor). Pass ?stream=ndjson instead to page
+// through large result sets as newline-delimited JSON.
 func (h *ModelHandler) List(c *fiber.Ctx) error {
 	ctx := requestContext(c)
 
-	filter := models.ModelFilter{
-		// Existing filters
-		Authors:      parseQueryArray(c, "author"),
-		ModelNames:   parseQueryArray(c, "model_name"),
-		EndpointTags: parseQueryArray(c, "endpoint_tag"),
-		Providers:    parseQueryArray(c, "provider"),
-
-		// NEW: Advanced filters
-		InputModalities:   parseQueryArray(c, "input_modality"),
-		OutputModalities:  parseQueryArray(c, "output_modality"),
-		MinContextLength:  parseQueryInt(c, "min_context_length"),
-		MaxPromptCost:     parseQueryString(c, "max_prompt_cost"),
-		MaxCompletionCost: parseQueryString(c, "max_completion_cost"),
-		SupportedParams:   parseQueryArray(c, "supported_param"),
-		Status:            parseQueryInt(c, "status"),
-		Quantizations:     parseQueryArray(c, "quantization"),
-	}
-
-	items, err := h.service.List(ctx, filter)
+	var filter models.ModelFilter
+	if err := mirc.BindQuery(c, &filter); err != nil {
+		return errorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+
+	if c.Query("stream") == "ndjson" {
+		return h.streamList(c, ctx, filter)
+	}
+
+	limit := normalizeLimit(c.QueryInt("limit", defaultStreamLimit), h.maxLimit, defaultStreamLimit)
+
+	cursor, err := cursorFromQuery(c)
+	if err != nil {
+		return errorResponse(c, http.StatusBadRequest, "invalid cursor")
+	}
+
+	items, err := h.service.ListPage(ctx, filter, cursor, limit)
 	if err != nil {
 		return errorResponse(c, http.StatusInternalServerError, err.Error())
 	}
 
-	return successResponse(c, http.StatusOK, items)
+	envelope := listEnvelope{Items: items}
+	if len(items) == limit {
+		last := items[len(items)-1]
+		envelope.NextCursor = repository.EncodeStreamCursor(repository.StreamCursor{
+			LastID:        last.ID,
+			LastModelName: last.ModelName,
+		})
+	}
+
+	return successResponse(c, http.StatusOK, envelope)
+}
+
+// cursorFromQuery decodes ?cursor=, returning a nil cursor when absent.
+func cursorFromQuery(c *fiber.Ctx) (*repository.StreamCursor, error) {
+	raw := c.Query("cursor")
+	if raw == "" {
+		return nil, nil
+	}
+	decoded, err := repository.DecodeStreamCursor(raw)
+	if err != nil {
+		return nil, err
+	}
+	return &decoded, nil
+}
+
+// streamList emits one JSON object per model of the requested page. The
+// page is loaded in full before encoding, and the rows are written into
+// the response body, which is sent once the handler returns; rows are not
+// flushed to the client one by one. It stops emitting further
+// rows once ctx is canceled - which happens when the server begins a
+// graceful shutdown (see registerRequestContext/Server.Shutdown), not on a
+// per-client disconnect: fasthttp's RequestCtx only ever cancels on server
+// shutdown, it has no per-connection disconnect signal to key off. Use
+// ?limit= and ?cursor= (from a prior response's Link header) to page
+// through the result.
+func (h *ModelHandler) streamList(c *fiber.Ctx, ctx context.Context, filter models.ModelFilter) error {
+	limit := normalizeLimit(c.QueryInt("limit", defaultStreamLimit), h.maxLimit, defaultStreamLimit)
+
+	cursor, err := cursorFromQuery(c)
+	if err != nil {
+		return errorResponse(c, http.StatusBadRequest, "invalid cursor")
+	}
+
+	items, err := h.service.ListPage(ctx, filter, cursor, limit)
+	if err != nil {
+		return errorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+
+	if estimate, err := h.service.EstimateCount(ctx, filter); err == nil && estimate > 0 {
+		c.Set("X-Total-Estimate", strconv.FormatInt(estimate, 10))
+	}
+
+	c.Set(fiber.HeaderContentType, "application/x-ndjson")
+
+	encoder := json.NewEncoder(c.Response().BodyWriter())
+	var last *models.Model
+	for i := range items {
+		if ctx.Err() != nil {
+			break // server shutting down; stop emitting rows
+		}
+		if err := encoder.Encode(items[i]); err != nil {
+			return err
+		}
+		last = &items[i]
+	}
+
+	if last != nil && len(items) == limit {
+		next := repository.EncodeStreamCursor(repository.StreamCursor{
+			LastID:        last.ID,
+			LastModelName: last.ModelName,
+		})
+		c.Set(fiber.HeaderLink, fmt.Sprintf(`</models?stream=ndjson&cursor=%s>; rel="next"`, next))
+	}
+
+	return nil
 }
 
 // GetByProviderAndName fetches a model by provider and model name.
@@ -88,7 +185,7 @@ func (h *ModelHandler) Upsert(c *fiber.Ctx) error {
 	body.LastUpdated = time.Time{}
 
 	ctx := requestContext(c)
-	result, err := h.service.Upsert(ctx, &body)
+	result, err := h.service.Upsert(ctx, &body, upsertOptionsFromQuery(c))
 	if err != nil {
 		return errorResponse(c, http.StatusInternalServerError, err.Error())
 	}
@@ -96,6 +193,213 @@ func (h *ModelHandler) Upsert(c *fiber.Ctx) error {
 	return successResponse(c, http.StatusCreated, result)
 }
 
+// Check validates a proposed model payload against the same rules Upsert
+// applies, without writing anything to the database. Use it to preview
+// what an Upsert would change, or to catch schema/data mistakes in CI
+// before they ever reach the database.
+func (h *ModelHandler) Check(c *fiber.Ctx) error {
+	var body models.Model
+	if err := c.BodyParser(&body); err != nil {
+		return errorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	if err := validateModel(body); err != nil {
+		return errorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	report, err := h.service.Check(requestContext(c), &body)
+	if err != nil {
+		return errorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+
+	return successResponse(c, http.StatusOK, report)
+}
+
+// upsertOptionsFromQuery reads ?mode=merge|replace, defaulting to replace
+// so the prior create-or-fail endpoint's implicit "whole object" semantics
+// are preserved when the caller doesn't opt into a partial update.
+func upsertOptionsFromQuery(c *fiber.Ctx) repository.UpsertOptions {
+	if c.Query("mode") == "merge" {
+		return repository.UpsertOptions{Mode: repository.UpsertMerge}
+	}
+	return repository.UpsertOptions{Mode: repository.UpsertReplace}
+}
+
+// bulkImportResult reports the outcome of importing a single NDJSON line.
+type bulkImportResult struct {
+	Line  int           `json:"line"`
+	OK    bool          `json:"ok"`
+	Model *models.Model `json:"model,omitempty"`
+	Error string        `json:"error,omitempty"`
+}
+
+// BulkImport accepts NDJSON (one models.Model per line) and upserts each
+// in turn, writing one bulkImportResult per line into the response body so
+// a caller sees every line's outcome, failures included. The request body
+// is read in full before the first line is processed, and the results are
+// sent together once the handler returns, not as each line completes.
+func (h *ModelHandler) BulkImport(c *fiber.Ctx) error {
+	ctx := requestContext(c)
+	opts := upsertOptionsFromQuery(c)
+
+	c.Set(fiber.HeaderContentType, "application/x-ndjson")
+	encoder := json.NewEncoder(c.Response().BodyWriter())
+
+	scanner := bufio.NewScanner(bytes.NewReader(c.Body()))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxBulkLineSize)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+
+		if err := encoder.Encode(h.importLine(ctx, line, raw, opts)); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return errorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return nil
+}
+
+func (h *ModelHandler) importLine(ctx context.Context, line int, raw []byte, opts repository.UpsertOptions) bulkImportResult {
+	var body models.Model
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return bulkImportResult{Line: line, Error: err.Error()}
+	}
+	if err := validateModel(body); err != nil {
+		return bulkImportResult{Line: line, Error: err.Error()}
+	}
+
+	body.ID = 0
+	body.CreatedAt = time.Time{}
+	body.LastUpdated = time.Time{}
+
+	result, err := h.service.Upsert(ctx, &body, opts)
+	if err != nil {
+		return bulkImportResult{Line: line, Error: err.Error()}
+	}
+	return bulkImportResult{Line: line, OK: true, Model: result}
+}
+
+// Export dumps the full registry. ?format=ndjson (the default) streams one
+// model per line; ?format=json returns a single JSON array.
+func (h *ModelHandler) Export(c *fiber.Ctx) error {
+	ctx := requestContext(c)
+
+	items, err := h.service.List(ctx, models.ModelFilter{})
+	if err != nil {
+		return errorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+
+	if c.Query("format", "ndjson") == "json" {
+		return successResponse(c, http.StatusOK, items)
+	}
+
+	c.Set(fiber.HeaderContentType, "application/x-ndjson")
+	encoder := json.NewEncoder(c.Response().BodyWriter())
+	for i := range items {
+		if err := encoder.Encode(items[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete soft-deletes the model identified by provider/name; the row and
+// its pricing history are kept so Restore and History keep working.
+func (h *ModelHandler) Delete(c *fiber.Ctx) error {
+	provider := c.Params("provider")
+	name := c.Params("name")
+	if provider == "" || name == "" {
+		return errorResponse(c, http.StatusBadRequest, "provider and model name are required")
+	}
+
+	ctx := requestContext(c)
+	err := h.service.Delete(ctx, provider, name)
+	switch {
+	case err == nil:
+		return successResponse(c, http.StatusNoContent, nil)
+	case errors.Is(err, repository.ErrNotFound):
+		return errorResponse(c, http.StatusNotFound, "model not found")
+	default:
+		return errorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+}
+
+// Restore un-deletes a previously soft-deleted model.
+func (h *ModelHandler) Restore(c *fiber.Ctx) error {
+	provider := c.Params("provider")
+	name := c.Params("name")
+	if provider == "" || name == "" {
+		return errorResponse(c, http.StatusBadRequest, "provider and model name are required")
+	}
+
+	ctx := requestContext(c)
+	item, err := h.service.Restore(ctx, provider, name)
+	switch {
+	case err == nil:
+		return successResponse(c, http.StatusOK, item)
+	case errors.Is(err, repository.ErrNotFound):
+		return errorResponse(c, http.StatusNotFound, "model not found or not deleted")
+	default:
+		return errorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+}
+
+// History returns the model identified by provider/name as it stood at
+// the instant given by the required ?at=<RFC3339 timestamp> parameter.
+func (h *ModelHandler) History(c *fiber.Ctx) error {
+	provider := c.Params("provider")
+	name := c.Params("name")
+	if provider == "" || name == "" {
+		return errorResponse(c, http.StatusBadRequest, "provider and model name are required")
+	}
+
+	at, err := time.Parse(time.RFC3339, c.Query("at"))
+	if err != nil {
+		return errorResponse(c, http.StatusBadRequest, "at must be an RFC3339 timestamp")
+	}
+
+	ctx := requestContext(c)
+	item, err := h.service.History(ctx, provider, name, at)
+	switch {
+	case err == nil:
+		return successResponse(c, http.StatusOK, item)
+	case errors.Is(err, repository.ErrNotFound):
+		return errorResponse(c, http.StatusNotFound, "model not found at that instant")
+	default:
+		return errorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+}
+
+// Health returns the per-endpoint health status matrix for the model
+// identified by provider/name, as last recorded by the background health
+// Scheduler (status/message/last_checked_at on each entry).
+func (h *ModelHandler) Health(c *fiber.Ctx) error {
+	provider := c.Params("provider")
+	name := c.Params("name")
+	if provider == "" || name == "" {
+		return errorResponse(c, http.StatusBadRequest, "provider and model name are required")
+	}
+
+	ctx := requestContext(c)
+	item, err := h.service.GetByProviderAndName(ctx, provider, name)
+	switch {
+	case err == nil:
+		return successResponse(c, http.StatusOK, item.Providers)
+	case errors.Is(err, repository.ErrNotFound):
+		return errorResponse(c, http.StatusNotFound, "model not found")
+	default:
+		return errorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+}
+
 func validateModel(m models.Model) error {
 	if m.Author == "" {
 		return errors.New("author is required")
@@ -1,22 +1,41 @@
 package api
 
 import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/adaptive/adaptive-model-registry/internal/mirc"
 	"github.com/adaptive/adaptive-model-registry/internal/models"
+	"github.com/adaptive/adaptive-model-registry/internal/repository"
 	"github.com/adaptive/adaptive-model-registry/internal/services"
-	"github.com/gofiber/fiber/v2"
 )
 
+const defaultProviderLimit = 100
+
 // ProviderHandler handles provider-related HTTP requests.
 type ProviderHandler struct {
-	service *services.ProviderService
+	service  *services.ProviderService
+	maxLimit int
+}
+
+// NewProviderHandler constructs a ProviderHandler. maxLimit caps ?limit= on
+// the paginated List endpoint.
+func NewProviderHandler(service *services.ProviderService, maxLimit int) *ProviderHandler {
+	return &ProviderHandler{service: service, maxLimit: maxLimit}
 }
 
-// NewProviderHandler constructs a ProviderHandler.
-func NewProviderHandler(service *services.ProviderService) *ProviderHandler {
-	return &ProviderHandler{service: service}
+// providerListEnvelope is the response body for a paginated GET /providers
+// page: the page of matching providers, plus the cursor to pass as
+// ?cursor= for the next one (empty once there isn't one).
+type providerListEnvelope struct {
+	Items      []models.Provider `json:"items"`
+	NextCursor string            `json:"next_cursor,omitempty"`
 }
 
-// List returns providers matching optional filter criteria.
+// List returns one page of providers matching optional filter criteria,
+// bounded by ?limit= (default 100, capped server-side) and advanced via
+// ?cursor= (from the previous page's next_cursor).
 // Query parameters:
 //   - tags: comma-separated list of tags to filter by
 //   - status: endpoint status filter (integer)
@@ -25,23 +44,33 @@ func NewProviderHandler(service *services.ProviderService) *ProviderHandler {
 //   - min_context_length: minimum context length (integer)
 //   - has_pricing: filter by pricing availability (true/false)
 //   - quantizations: comma-separated list of quantizations
+//   - limit, cursor: pagination
 func (h *ProviderHandler) List(c *fiber.Ctx) error {
-	filter := models.ProviderFilter{
-		Tags:             parseQueryArray(c, "tags"),
-		Status:           parseQueryInt(c, "status"),
-		InputModalities:  parseQueryArray(c, "input_modalities"),
-		OutputModalities: parseQueryArray(c, "output_modalities"),
-		MinContextLength: parseQueryInt(c, "min_context_length"),
-		HasPricing:       parseQueryBool(c, "has_pricing"),
-		Quantizations:    parseQueryArray(c, "quantizations"),
+	var filter models.ProviderFilter
+	if err := mirc.BindQuery(c, &filter); err != nil {
+		return errorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+
+	limit := normalizeLimit(c.QueryInt("limit", defaultProviderLimit), h.maxLimit, defaultProviderLimit)
+
+	var cursor string
+	if raw := c.Query("cursor"); raw != "" {
+		decoded, err := repository.DecodeProviderCursor(raw)
+		if err != nil {
+			return errorResponse(c, http.StatusBadRequest, "invalid cursor")
+		}
+		cursor = decoded
 	}
 
-	providers, err := h.service.List(c.Context(), filter)
+	providers, err := h.service.ListPage(requestContext(c), filter, cursor, limit)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": "Failed to list providers",
-		})
+		return errorResponse(c, http.StatusInternalServerError, "failed to list providers")
+	}
+
+	envelope := providerListEnvelope{Items: providers}
+	if len(providers) == limit {
+		envelope.NextCursor = repository.EncodeProviderCursor(providers[len(providers)-1].Name)
 	}
 
-	return c.JSON(providers)
+	return successResponse(c, http.StatusOK, envelope)
 }
@@ -1,31 +1,161 @@
 package api
 
 import (
+	"log"
+	"strings"
+
 	"github.com/gofiber/fiber/v2"
 	"gorm.io/gorm"
 
+	"github.com/adaptive/adaptive-model-registry/internal/api/graph"
 	"github.com/adaptive/adaptive-model-registry/internal/config"
+	"github.com/adaptive/adaptive-model-registry/internal/ratelimit"
 	"github.com/adaptive/adaptive-model-registry/internal/services"
+	"github.com/adaptive/adaptive-model-registry/internal/sync"
 )
 
+//go:generate go run ../../cmd/mirc -in mir.go -out routes_gen.go -pkg api
+
 // Deps groups dependencies required by the API handlers.
 type Deps struct {
 	Config    config.Config
 	DB        *gorm.DB
 	Models    *services.ModelService
 	Providers *services.ProviderService
+	// Search is nil when no Elasticsearch URL is configured, which
+	// disables GET /search entirely rather than mounting a handler that
+	// would fail every request.
+	Search *services.SearchService
+	// RateLimiter is nil when Config.RateLimitLimit <= 0, which disables
+	// rate limiting entirely rather than mounting a middleware that would
+	// reject every request against a misconfigured limit.
+	RateLimiter ratelimit.RateLimiter
+	// Sync is nil when Config.SyncSources is empty, which disables the
+	// admin sync routes entirely rather than mounting a handler with
+	// nothing configured to control.
+	Sync *sync.Scheduler
 }
 
-// Register mounts all API routes on the provided Fiber app.
+// Register mounts all API routes on the provided Fiber app. Route wiring
+// for the mir-tagged REST resources is generated from mir.go into
+// routes_gen.go; this builds the handler instances, installs the
+// request-context and rate-limit middleware, and mounts the routes with
+// no mir tag: the root route plus the GraphQL, search and sync routes.
 func Register(app *fiber.App, deps Deps) {
-	models := NewModelHandler(deps.Models)
-	providers := NewProviderHandler(deps.Providers)
-	health := NewHealthHandler(deps.DB)
+	models := NewModelHandler(deps.Models, deps.Config.ListMaxLimit)
+	providers := NewProviderHandler(deps.Providers, deps.Config.ListMaxLimit)
+	health := NewHealthHandler(deps.DB, deps.Models)
+	debug := NewDebugHandler(deps.Models)
+
+	registerRequestContext(app)
+	registerRateLimit(app, deps)
 
 	app.Get("/", Root)
-	app.Get("/health", health.Check)
-	app.Get("/models", models.List)
-	app.Get("/models/:provider/:name", models.GetByProviderAndName)
-	app.Post("/models", models.Upsert)
-	app.Get("/providers", providers.List)
+	registerGenerated(app, debug, health, models, providers)
+
+	registerGraph(app, deps)
+	registerSearch(app, deps)
+	registerSync(app, deps)
+}
+
+// registerRequestContext wires every request's c.UserContext() to Fiber's
+// own fasthttp RequestCtx, which is itself a context.Context whose Done()
+// channel closes when the server begins a graceful shutdown (see
+// Server.Shutdown). Without this, nothing ever calls SetUserContext, so
+// c.UserContext() silently defaults to an uncancelable context.Background()
+// - which requestContext, the rate-limit middleware, and the GraphQL
+// handler all build their per-request context from. It must run before
+// every other middleware/handler that reads c.UserContext().
+func registerRequestContext(app *fiber.App) {
+	app.Use(func(c *fiber.Ctx) error {
+		c.SetUserContext(c.Context())
+		return c.Next()
+	})
+}
+
+// registerRateLimit mounts a single rate-limit middleware covering every
+// route: it resolves the request path against
+// Config.RateLimitRouteOverrides (longest matching prefix wins) and
+// falls back to the global limit/interval when nothing matches. It must
+// run before the route registrations below so Fiber's middleware stack
+// wraps them.
+//
+// This stays a single Middleware instance rather than one per override
+// so a request only ever spends one token against one bucket — stacking
+// a global app.Use("/") alongside per-route app.Use(route, ...) would
+// have both run for an overridden path and, since they'd share the same
+// caller-derived key, silently corrupt each other's bucket state.
+func registerRateLimit(app *fiber.App, deps Deps) {
+	if deps.RateLimiter == nil {
+		return
+	}
+
+	global := ratelimit.ConfigRateLimit{Limit: deps.Config.RateLimitLimit, Interval: deps.Config.RateLimitInterval}
+	globalAuthenticated := ratelimit.ConfigRateLimit{Limit: deps.Config.RateLimitAuthenticatedLimit, Interval: deps.Config.RateLimitInterval}
+	overrides := deps.Config.RateLimitRouteOverrides
+
+	resolve := func(c *fiber.Ctx) (string, ratelimit.ConfigRateLimit, ratelimit.ConfigRateLimit) {
+		path := c.Path()
+		matched := ""
+		for route := range overrides {
+			if strings.HasPrefix(path, route) && len(route) > len(matched) {
+				matched = route
+			}
+		}
+		if matched == "" {
+			return "global", global, globalAuthenticated
+		}
+		// An override applies the same limit to both buckets on that
+		// route; splitting authenticated/anonymous further per-route
+		// isn't something Config.RateLimitRouteOverrides models today.
+		cfg := overrides[matched]
+		return matched, cfg, cfg
+	}
+
+	app.Use(ratelimit.Middleware(deps.RateLimiter, ratelimit.Options{Resolve: resolve}))
+}
+
+// registerSearch mounts GET /search when an Elasticsearch-backed
+// SearchService is configured. It's wired outside mir.go, alongside
+// registerGraph, since both are optional subsystems rather than
+// always-on REST resources.
+func registerSearch(app *fiber.App, deps Deps) {
+	if deps.Search == nil {
+		return
+	}
+	search := NewSearchHandler(deps.Search, deps.Config.ListMaxLimit)
+	app.Get("/search", search.Search)
+}
+
+// registerSync mounts the admin sync.Scheduler routes when one or more
+// sources are configured. It's wired outside mir.go, alongside
+// registerSearch, since it's an optional subsystem rather than an
+// always-on REST resource.
+func registerSync(app *fiber.App, deps Deps) {
+	if deps.Sync == nil {
+		return
+	}
+	handler := NewSyncHandler(deps.Sync)
+	app.Get("/admin/sync", handler.Status)
+	app.Post("/admin/sync/:source/trigger", handler.Trigger)
+	app.Post("/admin/sync/:source/pause", handler.Pause)
+	app.Post("/admin/sync/:source/resume", handler.Resume)
+}
+
+// registerGraph mounts the GraphQL query surface alongside the REST
+// routes above. A schema build failure is a programming error (a bad
+// type definition), not a runtime one, so it's fatal at startup rather
+// than surfaced per-request.
+func registerGraph(app *fiber.App, deps Deps) {
+	schema, err := graph.NewSchema(graph.Deps{
+		Models:    deps.Models,
+		Providers: deps.Providers,
+		MaxLimit:  deps.Config.ListMaxLimit,
+	})
+	if err != nil {
+		log.Fatalf("build graphql schema: %v", err)
+	}
+
+	app.Post("/graphql", graph.Handler(schema))
+	app.Get("/graphql/playground", graph.PlaygroundHandler("/graphql"))
 }
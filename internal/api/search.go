@@ -0,0 +1,80 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/adaptive/adaptive-model-registry/internal/search"
+	"github.com/adaptive/adaptive-model-registry/internal/services"
+)
+
+const defaultSearchLimit = 20
+
+// SearchHandler exposes full-text search and facet aggregation over the
+// Elasticsearch-backed index, alongside the Postgres-backed /models
+// filters.
+type SearchHandler struct {
+	service  *services.SearchService
+	maxLimit int
+}
+
+// NewSearchHandler constructs a SearchHandler. maxLimit caps ?limit= the
+// same way NewModelHandler's does.
+func NewSearchHandler(service *services.SearchService, maxLimit int) *SearchHandler {
+	return &SearchHandler{service: service, maxLimit: maxLimit}
+}
+
+// Search runs ?q= as a full-text query over indexed model endpoints,
+// narrowed by the same filter vocabulary as GET /models where the index
+// carries an equivalent field, and returns requested ?facet= terms
+// aggregations alongside the hits.
+func (h *SearchHandler) Search(c *fiber.Ctx) error {
+	ctx := requestContext(c)
+
+	limit := normalizeLimit(c.QueryInt("limit", defaultSearchLimit), h.maxLimit, defaultSearchLimit)
+
+	filter := search.SearchFilter{
+		Providers:        splitQuery(c.Query("provider")),
+		Quantizations:    splitQuery(c.Query("quantization")),
+		InputModalities:  splitQuery(c.Query("input_modality")),
+		OutputModalities: splitQuery(c.Query("output_modality")),
+	}
+	if raw := c.Query("min_context_length"); raw != "" {
+		if v, err := strconv.Atoi(raw); err == nil {
+			filter.MinContextLength = &v
+		}
+	}
+	if raw := c.Query("max_prompt_cost"); raw != "" {
+		if v, err := strconv.ParseFloat(raw, 64); err == nil {
+			filter.MaxPromptCost = &v
+		}
+	}
+
+	facets := splitQuery(c.Query("facet"))
+
+	result, err := h.service.SearchModels(ctx, c.Query("q"), filter, facets, limit)
+	if err != nil {
+		return errorResponse(c, http.StatusInternalServerError, err.Error())
+	}
+	return successResponse(c, http.StatusOK, result)
+}
+
+// splitQuery splits a comma-separated query param into its non-empty,
+// trimmed parts, returning nil (not an empty slice) when raw is empty so
+// callers can treat it as "filter not set".
+func splitQuery(raw string) []string {
+	if raw == "" {
+		return nil
+	}
+	parts := strings.Split(raw, ",")
+	result := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			result = append(result, p)
+		}
+	}
+	return result
+}
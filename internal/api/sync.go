@@ -0,0 +1,64 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/adaptive/adaptive-model-registry/internal/sync"
+)
+
+// SyncHandler exposes operator control over the background sync.Scheduler:
+// each source's last-run status, and the ability to trigger/pause/resume
+// a source without a redeploy.
+type SyncHandler struct {
+	scheduler *sync.Scheduler
+}
+
+// NewSyncHandler constructs a SyncHandler backed by scheduler.
+func NewSyncHandler(scheduler *sync.Scheduler) *SyncHandler {
+	return &SyncHandler{scheduler: scheduler}
+}
+
+// Status returns every configured source's current state and last-run
+// outcome.
+func (h *SyncHandler) Status(c *fiber.Ctx) error {
+	return successResponse(c, http.StatusOK, h.scheduler.Status())
+}
+
+// Trigger runs the :source source immediately, bypassing its schedule
+// and any pause, and blocks until that run completes.
+func (h *SyncHandler) Trigger(c *fiber.Ctx) error {
+	if err := h.scheduler.TriggerNow(requestContext(c), c.Params("source")); err != nil {
+		return syncErrorResponse(c, err)
+	}
+	return successResponse(c, http.StatusOK, nil)
+}
+
+// Pause stops :source from running on its schedule until Resume.
+func (h *SyncHandler) Pause(c *fiber.Ctx) error {
+	if err := h.scheduler.Pause(c.Params("source")); err != nil {
+		return syncErrorResponse(c, err)
+	}
+	return successResponse(c, http.StatusOK, nil)
+}
+
+// Resume re-enables :source's schedule after a Pause.
+func (h *SyncHandler) Resume(c *fiber.Ctx) error {
+	if err := h.scheduler.Resume(c.Params("source")); err != nil {
+		return syncErrorResponse(c, err)
+	}
+	return successResponse(c, http.StatusOK, nil)
+}
+
+// syncErrorResponse maps a Scheduler error to a status code: an unknown
+// source name is a 404, while any other error (a genuine fetch/import/
+// upsert failure for a source that does exist) is a 500 — Trigger can
+// return both, so callers can't assume every error means "not found."
+func syncErrorResponse(c *fiber.Ctx, err error) error {
+	if errors.Is(err, sync.ErrUnknownSource) {
+		return errorResponse(c, http.StatusNotFound, err.Error())
+	}
+	return errorResponse(c, http.StatusInternalServerError, err.Error())
+}
@@ -4,13 +4,21 @@ import (
 	"context"
 
 	"github.com/gofiber/fiber/v2"
+
+	"github.com/adaptive/adaptive-model-registry/internal/repository"
 )
 
+// requestContext returns the context request handlers should pass down to
+// services/repositories: c.UserContext(), which registerRequestContext
+// wires to the request's own fasthttp RequestCtx so it's canceled when the
+// server begins a graceful shutdown, plus repository.WithNoCache when the
+// client sent Cache-Control: no-cache.
 func requestContext(c *fiber.Ctx) context.Context {
-	if ctx := c.UserContext(); ctx != nil {
-		return ctx
+	ctx := c.UserContext()
+	if c.Get(fiber.HeaderCacheControl) == "no-cache" {
+		ctx = repository.WithNoCache(ctx)
 	}
-	return context.Background()
+	return ctx
 }
 
 func errorResponse(c *fiber.Ctx, status int, message string) error {
@@ -27,3 +35,22 @@ func successResponse(c *fiber.Ctx, status int, payload interface{}) error {
 	}
 	return c.Status(status).JSON(payload)
 }
+
+// normalizeLimit clamps requested to (0, max]: a non-positive requested
+// value falls back to fallback, but one above max is capped to max
+// rather than reset to fallback, so a client asking for more than the
+// configured ceiling still gets the ceiling instead of the (usually much
+// smaller) default. max itself misconfigured to <= 0 falls back to
+// fallback.
+func normalizeLimit(requested, max, fallback int) int {
+	if max <= 0 {
+		return fallback
+	}
+	if requested <= 0 {
+		return fallback
+	}
+	if requested > max {
+		return max
+	}
+	return requested
+}
@@ -4,33 +4,106 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
+
+	"github.com/adaptive/adaptive-model-registry/internal/database"
+	"github.com/adaptive/adaptive-model-registry/internal/ratelimit"
 )
 
 // Config captures runtime configuration for the service.
 type Config struct {
-	Port            string
-	DatabaseURL     string
-	ReadTimeout     time.Duration
-	WriteTimeout    time.Duration
-	ShutdownTimeout time.Duration
+	Port              string
+	DatabaseURL       string
+	DatabaseDriver    database.Driver
+	ReadTimeout       time.Duration
+	WriteTimeout      time.Duration
+	ShutdownTimeout   time.Duration
+	CacheSize         int           // max entries held by the model List/GetByProviderAndName cache; 0 disables caching
+	CacheTTL          time.Duration // per-entry TTL; 0 means entries only expire via LRU eviction
+	ImportSourceURL   string        // catalog URL the periodic sync worker pulls from; empty disables it
+	ImportInterval    time.Duration // how often the sync worker re-pulls ImportSourceURL
+	ImportFormat      string        // catalog shape at ImportSourceURL: "openrouter" (default) or "litellm"
+	ListMaxLimit      int           // hard cap on ?limit= for the paginated models/providers list endpoints
+	AllowedModalities []string      // modality values ModelService.Check accepts; empty disables the check
+
+	HealthCheckInterval time.Duration // how often the health Scheduler reprobes every provider; <= 0 disables periodic probing
+
+	SearchURLs          []string      // Elasticsearch/OpenSearch addresses; empty disables the search index and GET /search
+	SearchIndexName     string        // index name the Indexer writes to and Repository reads from
+	SearchBatchSize     int           // Indexer flushes once this many pending writes have queued
+	SearchFlushInterval time.Duration // Indexer also flushes on this cadence regardless of batch size; <= 0 disables the periodic flush
+
+	RateLimitLimit              int           // requests per RateLimitInterval for anonymous callers on routes with no override; <= 0 disables rate limiting entirely
+	RateLimitInterval           time.Duration // window RateLimitLimit/RateLimitAuthenticatedLimit are measured over
+	RateLimitAuthenticatedLimit int           // requests per RateLimitInterval for callers presenting X-API-Key
+	RateLimitRedisURL           string        // non-empty selects the Redis-backed shared limiter instead of the in-process token bucket, so replicas share one limit
+	// RateLimitRouteOverrides maps a route prefix (e.g. "/providers") to
+	// tighter-or-looser limits than the global default, loaded from the
+	// YAML file at RATE_LIMIT_CONFIG_FILE when set.
+	RateLimitRouteOverrides map[string]ratelimit.ConfigRateLimit
+
+	// SyncSources configures the sync.Scheduler's catalogs, loaded from
+	// the YAML file at SYNC_CONFIG_FILE when set; empty disables the
+	// scheduler entirely.
+	SyncSources []SyncSourceConfig
 }
 
 // Load reads configuration from environment variables, applying sensible defaults.
 func Load() (Config, error) {
 	cfg := Config{
-		Port:            getEnvDefault("PORT", "3000"),
-		DatabaseURL:     os.Getenv("DATABASE_URL"),
-		ReadTimeout:     durationFromEnv("READ_TIMEOUT", 5*time.Second),
-		WriteTimeout:    durationFromEnv("WRITE_TIMEOUT", 5*time.Second),
-		ShutdownTimeout: durationFromEnv("SHUTDOWN_TIMEOUT", 10*time.Second),
+		Port:              getEnvDefault("PORT", "3000"),
+		DatabaseURL:       os.Getenv("DATABASE_URL"),
+		ReadTimeout:       durationFromEnv("READ_TIMEOUT", 5*time.Second),
+		WriteTimeout:      durationFromEnv("WRITE_TIMEOUT", 5*time.Second),
+		ShutdownTimeout:   durationFromEnv("SHUTDOWN_TIMEOUT", 10*time.Second),
+		CacheSize:         intFromEnv("CACHE_SIZE", 1000),
+		CacheTTL:          durationFromEnv("CACHE_TTL", 30*time.Second),
+		ImportSourceURL:   os.Getenv("IMPORT_SOURCE_URL"),
+		ImportInterval:    durationFromEnv("IMPORT_INTERVAL", time.Hour),
+		ImportFormat:      getEnvDefault("IMPORT_FORMAT", "openrouter"),
+		ListMaxLimit:      intFromEnv("LIST_MAX_LIMIT", 1000),
+		AllowedModalities: stringSliceFromEnv("ALLOWED_MODALITIES", []string{"text", "image", "audio", "file"}),
+
+		HealthCheckInterval: durationFromEnv("HEALTH_CHECK_INTERVAL", 5*time.Minute),
+
+		SearchURLs:          stringSliceFromEnv("SEARCH_URLS", nil),
+		SearchIndexName:     getEnvDefault("SEARCH_INDEX_NAME", "model_endpoints"),
+		SearchBatchSize:     intFromEnv("SEARCH_BATCH_SIZE", 100),
+		SearchFlushInterval: durationFromEnv("SEARCH_FLUSH_INTERVAL", 10*time.Second),
+
+		RateLimitLimit:              intFromEnv("RATE_LIMIT_LIMIT", 100),
+		RateLimitInterval:           durationFromEnv("RATE_LIMIT_INTERVAL", time.Minute),
+		RateLimitAuthenticatedLimit: intFromEnv("RATE_LIMIT_AUTHENTICATED_LIMIT", 1000),
+		RateLimitRedisURL:           os.Getenv("RATE_LIMIT_REDIS_URL"),
 	}
 
 	if cfg.DatabaseURL == "" {
 		return Config{}, errors.New("DATABASE_URL is required")
 	}
 
+	if file := os.Getenv("RATE_LIMIT_CONFIG_FILE"); file != "" {
+		overrides, err := loadRateLimitOverrides(file)
+		if err != nil {
+			return Config{}, fmt.Errorf("load RATE_LIMIT_CONFIG_FILE: %w", err)
+		}
+		cfg.RateLimitRouteOverrides = overrides
+	}
+
+	if file := os.Getenv("SYNC_CONFIG_FILE"); file != "" {
+		sources, err := loadSyncSources(file)
+		if err != nil {
+			return Config{}, fmt.Errorf("load SYNC_CONFIG_FILE: %w", err)
+		}
+		cfg.SyncSources = sources
+	}
+
+	// DATABASE_DRIVER overrides scheme detection, which matters for DSNs
+	// that don't carry a recognizable URL scheme (e.g. a raw MySQL
+	// "user:pass@tcp(host)/db" string).
+	cfg.DatabaseDriver = database.Driver(getEnvDefault("DATABASE_DRIVER", string(database.DetectDriver(cfg.DatabaseURL))))
+
 	return cfg, nil
 }
 
@@ -66,3 +139,29 @@ func durationFromEnv(key string, fallback time.Duration) time.Duration {
 	}
 	return fallback
 }
+
+func intFromEnv(key string, fallback int) int {
+	if value := os.Getenv(key); value != "" {
+		if parsed, err := strconv.Atoi(value); err == nil {
+			return parsed
+		}
+	}
+	return fallback
+}
+
+// stringSliceFromEnv splits a comma-separated env var into a trimmed,
+// non-empty slice, returning fallback when the var is unset.
+func stringSliceFromEnv(key string, fallback []string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	parts := strings.Split(value, ",")
+	result := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			result = append(result, p)
+		}
+	}
+	return result
+}
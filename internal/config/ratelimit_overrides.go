@@ -0,0 +1,55 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/adaptive/adaptive-model-registry/internal/ratelimit"
+)
+
+// rateLimitOverridesFile is the shape of the optional YAML file named by
+// RATE_LIMIT_CONFIG_FILE. It's kept separate from Config's env-loaded
+// scalars because per-route tuning is naturally nested, where the rest
+// of this package's flat RATE_LIMIT_* vars aren't.
+//
+// routes:
+//
+//	/providers:
+//	  limit: 50
+//	  interval: 1m
+//	/models:
+//	  limit: 500
+//	  interval: 1m
+type rateLimitOverridesFile struct {
+	Routes map[string]struct {
+		Limit    int    `yaml:"limit"`
+		Interval string `yaml:"interval"`
+	} `yaml:"routes"`
+}
+
+// loadRateLimitOverrides reads and parses path into a route-prefix to
+// ConfigRateLimit map.
+func loadRateLimitOverrides(path string) (map[string]ratelimit.ConfigRateLimit, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+
+	var parsed rateLimitOverridesFile
+	if err := yaml.Unmarshal(raw, &parsed); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+
+	overrides := make(map[string]ratelimit.ConfigRateLimit, len(parsed.Routes))
+	for route, limit := range parsed.Routes {
+		interval, err := time.ParseDuration(limit.Interval)
+		if err != nil {
+			return nil, fmt.Errorf("route %s: invalid interval %q: %w", route, limit.Interval, err)
+		}
+		overrides[route] = ratelimit.ConfigRateLimit{Limit: limit.Limit, Interval: interval}
+	}
+	return overrides, nil
+}
@@ -0,0 +1,92 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SyncSourceConfig configures one upstream catalog for the sync.Scheduler
+// to pull from, built from a syncSourcesFile entry.
+type SyncSourceConfig struct {
+	Name           string
+	URL            string
+	Format         string // catalog shape at URL: "openrouter" (default) or "litellm"
+	Schedule       string // robfig/cron/v3 expression, e.g. "@every 1h" or "0 */6 * * *"
+	Timeout        time.Duration
+	MaxRetries     int
+	InitialBackoff time.Duration
+}
+
+// syncSourcesFile is the shape of the optional YAML file named by
+// SYNC_CONFIG_FILE. Sources are naturally a list of nested records, where
+// the rest of this package's flat env vars aren't, so they get their own
+// file the same way RATE_LIMIT_CONFIG_FILE does for route overrides.
+//
+// sources:
+//   - name: openrouter
+//     url: https://openrouter.ai/api/v1/models
+//     format: openrouter
+//     schedule: "@every 1h"
+//     timeout: 30s
+//     max_retries: 3
+//     initial_backoff: 1s
+type syncSourcesFile struct {
+	Sources []struct {
+		Name           string `yaml:"name"`
+		URL            string `yaml:"url"`
+		Format         string `yaml:"format"`
+		Schedule       string `yaml:"schedule"`
+		Timeout        string `yaml:"timeout"`
+		MaxRetries     int    `yaml:"max_retries"`
+		InitialBackoff string `yaml:"initial_backoff"`
+	} `yaml:"sources"`
+}
+
+// loadSyncSources reads and parses path into a list of SyncSourceConfig.
+func loadSyncSources(path string) ([]SyncSourceConfig, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+
+	var parsed syncSourcesFile
+	if err := yaml.Unmarshal(raw, &parsed); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+
+	sources := make([]SyncSourceConfig, 0, len(parsed.Sources))
+	for _, src := range parsed.Sources {
+		timeout := 30 * time.Second
+		if src.Timeout != "" {
+			if timeout, err = time.ParseDuration(src.Timeout); err != nil {
+				return nil, fmt.Errorf("source %s: invalid timeout %q: %w", src.Name, src.Timeout, err)
+			}
+		}
+
+		initialBackoff := time.Second
+		if src.InitialBackoff != "" {
+			if initialBackoff, err = time.ParseDuration(src.InitialBackoff); err != nil {
+				return nil, fmt.Errorf("source %s: invalid initial_backoff %q: %w", src.Name, src.InitialBackoff, err)
+			}
+		}
+
+		format := src.Format
+		if format == "" {
+			format = "openrouter"
+		}
+
+		sources = append(sources, SyncSourceConfig{
+			Name:           src.Name,
+			URL:            src.URL,
+			Format:         format,
+			Schedule:       src.Schedule,
+			Timeout:        timeout,
+			MaxRetries:     src.MaxRetries,
+			InitialBackoff: initialBackoff,
+		})
+	}
+	return sources, nil
+}
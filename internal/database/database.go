@@ -4,15 +4,62 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 
+	"gorm.io/driver/mysql"
 	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 	"gorm.io/gorm/schema"
+
+	modernc "github.com/glebarez/sqlite"
+)
+
+// Driver identifies a supported GORM dialect.
+type Driver string
+
+const (
+	DriverPostgres      Driver = "postgres"
+	DriverMySQL         Driver = "mysql"
+	DriverSQLite        Driver = "sqlite"         // CGO, backed by mattn/go-sqlite3
+	DriverSQLiteModernc Driver = "sqlite+modernc" // pure Go, backed by modernc.org/sqlite
 )
 
-// Open returns a configured GORM connection using the supplied DSN.
+// DetectDriver infers the Driver from a DSN's URL scheme. DSNs without a
+// recognized scheme (e.g. a bare Postgres DSN, or a MySQL
+// "user:pass@tcp(host)/db" string) default to Postgres, the historical
+// behavior before other drivers were supported.
+func DetectDriver(dsn string) Driver {
+	switch {
+	case strings.HasPrefix(dsn, "postgres://"), strings.HasPrefix(dsn, "postgresql://"):
+		return DriverPostgres
+	case strings.HasPrefix(dsn, "mysql://"):
+		return DriverMySQL
+	case strings.HasPrefix(dsn, "sqlite+modernc://"):
+		return DriverSQLiteModernc
+	case strings.HasPrefix(dsn, "sqlite://"):
+		return DriverSQLite
+	default:
+		return DriverPostgres
+	}
+}
+
+// Open returns a configured GORM connection, detecting the driver from
+// the DSN's URL scheme. Use OpenWithDriver to bypass detection, e.g. for
+// MySQL DSNs that don't carry a "mysql://" prefix.
 func Open(dsn string) (*gorm.DB, error) {
+	return OpenWithDriver(DetectDriver(dsn), dsn)
+}
+
+// OpenWithDriver returns a configured GORM connection for the given
+// driver and DSN.
+func OpenWithDriver(driver Driver, dsn string) (*gorm.DB, error) {
+	dialector, err := dialectorFor(driver, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("open database: %w", err)
+	}
+
 	cfg := &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Warn),
 		NamingStrategy: schema.NamingStrategy{
@@ -20,13 +67,28 @@ func Open(dsn string) (*gorm.DB, error) {
 		},
 	}
 
-	db, err := gorm.Open(postgres.Open(dsn), cfg)
+	db, err := gorm.Open(dialector, cfg)
 	if err != nil {
 		return nil, fmt.Errorf("open database: %w", err)
 	}
 	return db, nil
 }
 
+func dialectorFor(driver Driver, dsn string) (gorm.Dialector, error) {
+	switch driver {
+	case DriverPostgres:
+		return postgres.Open(dsn), nil
+	case DriverMySQL:
+		return mysql.Open(strings.TrimPrefix(dsn, "mysql://")), nil
+	case DriverSQLite:
+		return sqlite.Open(strings.TrimPrefix(dsn, "sqlite://")), nil
+	case DriverSQLiteModernc:
+		return modernc.Open(strings.TrimPrefix(dsn, "sqlite+modernc://")), nil
+	default:
+		return nil, fmt.Errorf("unsupported driver %q", driver)
+	}
+}
+
 // MustOpen panics when the database connection cannot be established.
 func MustOpen(dsn string) *gorm.DB {
 	db, err := Open(dsn)
@@ -36,6 +98,15 @@ func MustOpen(dsn string) *gorm.DB {
 	return db
 }
 
+// MustOpenWithDriver panics when the database connection cannot be established.
+func MustOpenWithDriver(driver Driver, dsn string) *gorm.DB {
+	db, err := OpenWithDriver(driver, dsn)
+	if err != nil {
+		panic(err)
+	}
+	return db
+}
+
 // SQLDB extracts the underlying *sql.DB handle.
 func SQLDB(db *gorm.DB) (*sql.DB, error) {
 	return db.DB()
@@ -0,0 +1,113 @@
+// Package health implements the background liveness probing that keeps
+// ModelEndpoint.Status/Message current: a Scheduler periodically walks
+// every known endpoint and hands it to a per-provider Prober, writing the
+// results back in one batch per cycle via
+// ModelService.RecordEndpointHealthBatch.
+package health
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// Status codes a Prober reports, matching the "status = 0" means active
+// convention ModelFilter/ProviderFilter already use elsewhere.
+const (
+	StatusHealthy   = 0
+	StatusUnhealthy = 1
+)
+
+// Result is what a Prober reports back for a single endpoint probe.
+type Result struct {
+	Status  int
+	Message string
+}
+
+// Prober issues a provider's lightweight liveness check once per probe
+// cycle. The Scheduler applies the single Result to every ModelEndpoint
+// under that provider_name, since the provider-level signals a Prober
+// can cheaply obtain (e.g. an OpenAI-compatible GET /models) aren't
+// addressable per specific model anyway.
+type Prober interface {
+	Probe(ctx context.Context, providerName string) Result
+}
+
+// Registry resolves a Prober by ModelEndpoint.ProviderName, the same way
+// services.NewImporter resolves an Importer by catalog format name.
+type Registry struct {
+	probers map[string]Prober
+}
+
+// NewRegistry builds a Registry with OpenAI-compatible probers wired up
+// for the providers whose public API shape this repo already knows about
+// (see internal/services/openrouter_adapter.go). A provider_name with no
+// registered Prober is left untouched by the Scheduler rather than
+// guessed at.
+func NewRegistry() *Registry {
+	client := &http.Client{Timeout: 10 * time.Second}
+	return &Registry{
+		probers: map[string]Prober{
+			"openai":    &openAICompatibleProber{client: client, baseURL: "https://api.openai.com/v1", provider: "openai", authHeader: "Authorization", authPrefix: "Bearer "},
+			"anthropic": &openAICompatibleProber{client: client, baseURL: "https://api.anthropic.com/v1", provider: "anthropic", authHeader: "x-api-key", authPrefix: ""},
+		},
+	}
+}
+
+// For returns the Prober registered for providerName and whether one
+// was found.
+func (r *Registry) For(providerName string) (Prober, bool) {
+	p, ok := r.probers[strings.ToLower(providerName)]
+	return p, ok
+}
+
+// openAICompatibleProber probes a provider that exposes an OpenAI-style
+// GET /models endpoint, as suggested by the "lightweight probe" this
+// subsystem was asked to issue.
+type openAICompatibleProber struct {
+	client     *http.Client
+	baseURL    string
+	provider   string
+	authHeader string
+	authPrefix string
+}
+
+func (p *openAICompatibleProber) Probe(ctx context.Context, providerName string) Result {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+"/models", nil)
+	if err != nil {
+		return Result{Status: StatusUnhealthy, Message: truncate(err.Error())}
+	}
+
+	if key := os.Getenv(strings.ToUpper(p.provider) + "_API_KEY"); key != "" {
+		req.Header.Set(p.authHeader, p.authPrefix+key)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return Result{Status: StatusUnhealthy, Message: truncate(err.Error())}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return Result{Status: StatusUnhealthy, Message: truncate(fmt.Sprintf("%s: %s", resp.Status, body))}
+	}
+
+	return Result{Status: StatusHealthy}
+}
+
+// maxMessageLen bounds the message written back to model_endpoints.message
+// so a verbose error body doesn't bloat the row.
+const maxMessageLen = 200
+
+func truncate(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) <= maxMessageLen {
+		return s
+	}
+	return s[:maxMessageLen] + "..."
+}
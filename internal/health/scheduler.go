@@ -0,0 +1,138 @@
+package health
+
+import (
+	"context"
+	"log"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/adaptive/adaptive-model-registry/internal/models"
+	"github.com/adaptive/adaptive-model-registry/internal/repository"
+	"github.com/adaptive/adaptive-model-registry/internal/services"
+)
+
+// endpointStore is the subset of ModelService the Scheduler needs, kept
+// narrow so it's easy to see exactly what the Scheduler touches.
+type endpointStore interface {
+	ListEndpointsForProbe(ctx context.Context) ([]models.ModelEndpoint, error)
+	RecordEndpointHealthBatch(ctx context.Context, updates []repository.EndpointHealthUpdate) error
+}
+
+// Scheduler drives periodic health probes across every known model
+// endpoint, grouped by provider_name so one slow provider request can't
+// delay another's: each provider's group is probed with a single Prober
+// call (see Prober), and the groups run concurrently. Since each
+// provider takes exactly one request per cycle, interval is itself the
+// per-provider rate limit.
+type Scheduler struct {
+	store    endpointStore
+	registry *Registry
+	interval time.Duration
+}
+
+// NewScheduler constructs a Scheduler that probes every provider every
+// interval (jittered ±10%). interval <= 0 disables periodic probing; Run
+// then probes once and returns.
+func NewScheduler(store *services.ModelService, registry *Registry, interval time.Duration) *Scheduler {
+	return &Scheduler{store: store, registry: registry, interval: interval}
+}
+
+// Run probes once immediately and then every s.interval (jittered) until
+// ctx is canceled.
+func (s *Scheduler) Run(ctx context.Context) {
+	s.probeOnce(ctx)
+	if s.interval <= 0 {
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(jitter(s.interval)):
+			s.probeOnce(ctx)
+		}
+	}
+}
+
+func (s *Scheduler) probeOnce(ctx context.Context) {
+	endpoints, err := s.store.ListEndpointsForProbe(ctx)
+	if err != nil {
+		log.Printf("health: list endpoints: %v", err)
+		return
+	}
+
+	byProvider := make(map[string][]models.ModelEndpoint, len(endpoints))
+	for _, e := range endpoints {
+		byProvider[e.ProviderName] = append(byProvider[e.ProviderName], e)
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		updates []repository.EndpointHealthUpdate
+	)
+	for provider, group := range byProvider {
+		wg.Add(1)
+		go func(provider string, group []models.ModelEndpoint) {
+			defer wg.Done()
+			groupUpdates := s.probeProvider(ctx, provider, group)
+			if len(groupUpdates) == 0 {
+				return
+			}
+			mu.Lock()
+			updates = append(updates, groupUpdates...)
+			mu.Unlock()
+		}(provider, group)
+	}
+	wg.Wait()
+
+	// One batch call for the whole cycle, so a cache-backed repository
+	// purges its List/GetByProviderAndName cache once per
+	// HealthCheckInterval instead of once per endpoint.
+	if len(updates) == 0 {
+		return
+	}
+	// RecordEndpointHealthBatch's error (when non-nil) is a join of one
+	// per-endpoint error per failed update, so this one log line still
+	// identifies every endpoint that didn't get recorded.
+	if err := s.store.RecordEndpointHealthBatch(ctx, updates); err != nil {
+		log.Printf("health: record batch: %v", err)
+	}
+}
+
+// probeProvider issues a single Prober call for provider and builds an
+// EndpointHealthUpdate from its Result for every endpoint in group, since
+// the provider-level signals a Prober can cheaply obtain aren't
+// addressable per specific model. Providers with no registered Prober
+// are left untouched.
+func (s *Scheduler) probeProvider(ctx context.Context, provider string, group []models.ModelEndpoint) []repository.EndpointHealthUpdate {
+	prober, ok := s.registry.For(provider)
+	if !ok {
+		return nil
+	}
+
+	result := prober.Probe(ctx, provider)
+	now := time.Now()
+	updates := make([]repository.EndpointHealthUpdate, len(group))
+	for i, endpoint := range group {
+		updates[i] = repository.EndpointHealthUpdate{
+			EndpointID: endpoint.ID,
+			Status:     result.Status,
+			Message:    result.Message,
+			CheckedAt:  now,
+		}
+	}
+	return updates
+}
+
+// jitter returns d plus or minus up to 10%, so many Schedulers starting
+// at once (e.g. across replicas) don't all probe in lockstep.
+func jitter(d time.Duration) time.Duration {
+	spread := d / 10
+	if spread <= 0 {
+		return d
+	}
+	return d - spread + time.Duration(rand.Int63n(int64(2*spread)))
+}
@@ -0,0 +1,142 @@
+// Package mirc implements a small mir-style route-generation tool: it
+// reads Go struct fields tagged `mir:"METHOD /path"` and emits the Fiber
+// route registration glue that would otherwise be written by hand.
+package mirc
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Route describes a single mir-tagged field: an HTTP method, a path
+// pattern, the API struct it was declared on, and the handler method
+// name (matched against the field name) that fulfils it.
+type Route struct {
+	Method string
+	Path   string
+	Struct string // e.g. "ModelsAPI"
+	Field  string // e.g. "List"
+}
+
+// ParseFile reads a Go source file and returns every mir-tagged route
+// declared on its struct types, in source order.
+func ParseFile(path string) ([]Route, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("mirc: parse %s: %w", path, err)
+	}
+
+	var routes []Route
+	ast.Inspect(file, func(n ast.Node) bool {
+		typeSpec, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+		structType, ok := typeSpec.Type.(*ast.StructType)
+		if !ok {
+			return true
+		}
+
+		for _, field := range structType.Fields.List {
+			if field.Tag == nil || len(field.Names) == 0 {
+				continue
+			}
+			tagValue, err := strconv.Unquote(field.Tag.Value)
+			if err != nil {
+				continue
+			}
+			mirTag := reflect.StructTag(tagValue).Get("mir")
+			if mirTag == "" {
+				continue
+			}
+
+			parts := strings.Fields(mirTag)
+			if len(parts) != 2 {
+				continue // malformed tag; skip rather than fail the whole generation
+			}
+
+			routes = append(routes, Route{
+				Method: parts[0],
+				Path:   parts[1],
+				Struct: typeSpec.Name.Name,
+				Field:  field.Names[0].Name,
+			})
+		}
+		return true
+	})
+
+	return routes, nil
+}
+
+// Generate renders the contents of routes_gen.go for the given routes.
+func Generate(pkg string, routes []Route) ([]byte, error) {
+	sort.SliceStable(routes, func(i, j int) bool { return routes[i].Struct < routes[j].Struct })
+
+	seen := map[string]string{} // handler var name -> struct name
+	var order []string
+	for _, r := range routes {
+		v := handlerVar(r.Struct)
+		if _, ok := seen[v]; !ok {
+			seen[v] = r.Struct
+			order = append(order, v)
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by internal/mirc from mir.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	buf.WriteString("import \"github.com/gofiber/fiber/v2\"\n\n")
+	buf.WriteString("// registerGenerated mounts every route declared via mir struct tags in\n")
+	buf.WriteString("// mir.go. Re-run `go generate ./internal/api` after editing the API structs.\n")
+	buf.WriteString("func registerGenerated(app *fiber.App")
+	for _, v := range order {
+		// "ModelsAPI" is served by ModelHandler, so a plural resource
+		// name loses its trailing "s" in the handler type name.
+		handlerType := strings.TrimSuffix(strings.TrimSuffix(seen[v], "API"), "s") + "Handler"
+		fmt.Fprintf(&buf, ", %s *%s", v, handlerType)
+	}
+	buf.WriteString(") {\n")
+
+	for _, r := range routes {
+		fmt.Fprintf(&buf, "\tapp.%s(%q, %s.%s)\n", fiberMethod(r.Method), r.Path, handlerVar(r.Struct), r.Field)
+	}
+	buf.WriteString("}\n")
+
+	return format.Source(buf.Bytes())
+}
+
+// handlerVar derives the registerGenerated parameter name for a mir
+// struct, e.g. "ModelsAPI" -> "models".
+func handlerVar(structName string) string {
+	name := strings.TrimSuffix(structName, "API")
+	if name == "" {
+		return "h"
+	}
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+func fiberMethod(method string) string {
+	switch strings.ToUpper(method) {
+	case "GET":
+		return "Get"
+	case "POST":
+		return "Post"
+	case "PUT":
+		return "Put"
+	case "PATCH":
+		return "Patch"
+	case "DELETE":
+		return "Delete"
+	default:
+		return strings.ToUpper(method[:1]) + strings.ToLower(method[1:])
+	}
+}
@@ -0,0 +1,90 @@
+package mirc
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// BindQuery populates dst's fields from the request's query parameters
+// using each field's `query:"name"` struct tag, replacing the repetitive
+// parseQueryArray/parseQueryInt/parseQueryBool call sites that used to
+// appear once per filter field. It understands the same shapes the
+// existing filter structs use: []string, *int, *string, and *bool.
+func BindQuery(c *fiber.Ctx, dst interface{}) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("mirc: BindQuery requires a pointer to struct, got %T", dst)
+	}
+
+	elem := v.Elem()
+	t := elem.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := field.Tag.Get("query")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		fv := elem.Field(i)
+		switch fv.Interface().(type) {
+		case []string:
+			fv.Set(reflect.ValueOf(queryArray(c, name)))
+		case *int:
+			fv.Set(reflect.ValueOf(queryInt(c, name)))
+		case *string:
+			fv.Set(reflect.ValueOf(queryString(c, name)))
+		case *bool:
+			fv.Set(reflect.ValueOf(queryBool(c, name)))
+		default:
+			return fmt.Errorf("mirc: BindQuery: unsupported field type %s on %s", field.Type, field.Name)
+		}
+	}
+
+	return nil
+}
+
+// queryArray extracts query parameters as a string slice.
+// Supports: ?key=val1&key=val2 OR ?key=val1,val2
+func queryArray(c *fiber.Ctx, key string) []string {
+	var results []string
+	for _, value := range c.Context().QueryArgs().PeekMulti(key) {
+		for part := range strings.SplitSeq(string(value), ",") {
+			if trimmed := strings.TrimSpace(part); trimmed != "" {
+				results = append(results, trimmed)
+			}
+		}
+	}
+	return results
+}
+
+func queryInt(c *fiber.Ctx, key string) *int {
+	val := c.QueryInt(key, -1)
+	if val == -1 {
+		return nil
+	}
+	return &val
+}
+
+func queryString(c *fiber.Ctx, key string) *string {
+	val := c.Query(key)
+	if val == "" {
+		return nil
+	}
+	return &val
+}
+
+func queryBool(c *fiber.Ctx, key string) *bool {
+	switch c.Query(key) {
+	case "true":
+		v := true
+		return &v
+	case "false":
+		v := false
+		return &v
+	default:
+		return nil
+	}
+}
@@ -2,18 +2,54 @@ package models
 
 // ModelFilter defines optional filtering criteria when listing models.
 // All fields support multiple values (OR logic within field, AND logic between fields).
+//
+// The `query` tag drives internal/mirc.BindQuery, which populates this
+// struct straight from request query parameters.
 type ModelFilter struct {
 	// Existing filters
-	Authors           []string `json:"authors,omitzero"`             // Filter by author(s) - OR logic
-	ModelNames        []string `json:"model_names,omitzero"`         // Filter by model name(s) - OR logic
-	EndpointTags      []string `json:"endpoint_tags,omitzero"`       // Filter by endpoint tag(s) - OR logic
-	Providers         []string `json:"providers,omitzero"`           // Filter by provider name(s) - OR logic
-	InputModalities   []string `json:"input_modalities,omitzero"`    // Filter by input modality
-	OutputModalities  []string `json:"output_modalities,omitzero"`   // Filter by output modality
-	MinContextLength  *int     `json:"min_context_length,omitzero"`  // Minimum context window
-	MaxPromptCost     *string  `json:"max_prompt_cost,omitzero"`     // Max cost per prompt token
-	MaxCompletionCost *string  `json:"max_completion_cost,omitzero"` // Max cost per completion token
-	SupportedParams   []string `json:"supported_params,omitzero"`    // Required supported parameters
-	Status            *int     `json:"status,omitzero"`              // Endpoint status filter
-	Quantizations     []string `json:"quantizations,omitzero"`       // Filter by quantization
+	Authors           []string `json:"authors,omitzero"             query:"author"`
+	ModelNames        []string `json:"model_names,omitzero"         query:"model_name"`
+	EndpointTags      []string `json:"endpoint_tags,omitzero"       query:"endpoint_tag"`
+	Providers         []string `json:"providers,omitzero"           query:"provider"`
+	InputModalities   []string `json:"input_modalities,omitzero"    query:"input_modality"`
+	OutputModalities  []string `json:"output_modalities,omitzero"   query:"output_modality"`
+	MinContextLength  *int     `json:"min_context_length,omitzero"  query:"min_context_length"`
+	MaxPromptCost     *string  `json:"max_prompt_cost,omitzero"     query:"max_prompt_cost"`
+	MaxCompletionCost *string  `json:"max_completion_cost,omitzero" query:"max_completion_cost"`
+	SupportedParams   []string `json:"supported_params,omitzero"    query:"supported_param"`
+	Status            *int     `json:"status,omitzero"              query:"status"`
+	Quantizations     []string `json:"quantizations,omitzero"       query:"quantization"`
+	IncludeDeleted    *bool    `json:"include_deleted,omitzero"     query:"include_deleted"`
+
+	// HealthyWithinMinutes restricts results to models with at least one
+	// endpoint the health Scheduler observed healthy within the last N
+	// minutes.
+	HealthyWithinMinutes *int `json:"healthy_within_minutes,omitzero" query:"healthy_within_minutes"`
+}
+
+// ProviderFilter defines optional filtering criteria when listing providers.
+// All fields support multiple values (OR logic within field, AND logic between fields).
+type ProviderFilter struct {
+	Tags             []string `json:"tags,omitzero"               query:"tags"`
+	Status           *int     `json:"status,omitzero"             query:"status"`
+	InputModalities  []string `json:"input_modalities,omitzero"   query:"input_modalities"`
+	OutputModalities []string `json:"output_modalities,omitzero"  query:"output_modalities"`
+	MinContextLength *int     `json:"min_context_length,omitzero" query:"min_context_length"`
+	HasPricing       *bool    `json:"has_pricing,omitzero"        query:"has_pricing"`
+	Quantizations    []string `json:"quantizations,omitzero"      query:"quantizations"`
+
+	// MaxPromptCost and MaxCompletionCost mirror ModelFilter's cost
+	// ceilings: a provider matches if at least one of its endpoints'
+	// pricing is at or under the given cost.
+	MaxPromptCost     *string `json:"max_prompt_cost,omitzero"     query:"max_prompt_cost"`
+	MaxCompletionCost *string `json:"max_completion_cost,omitzero" query:"max_completion_cost"`
+	// SupportedParams restricts results to providers serving at least one
+	// model that declares every parameter listed (AND between entries,
+	// mirroring ModelFilter.SupportedParams).
+	SupportedParams []string `json:"supported_params,omitzero" query:"supported_param"`
+
+	// HealthyWithinMinutes restricts results to providers with at least
+	// one endpoint the health Scheduler observed healthy within the last
+	// N minutes.
+	HealthyWithinMinutes *int `json:"healthy_within_minutes,omitzero" query:"healthy_within_minutes"`
 }
@@ -2,6 +2,9 @@ package models
 
 import (
 	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
 )
 
 // ModelPricing represents model pricing (database entity and API response)
@@ -14,12 +17,34 @@ type ModelPricing struct {
 	ImageCost             string `json:"image_cost,omitzero" gorm:"column:image_cost"`
 	WebSearchCost         string `json:"web_search_cost,omitzero" gorm:"column:web_search_cost"`
 	InternalReasoningCost string `json:"internal_reasoning_cost,omitzero" gorm:"column:internal_reasoning_cost"`
+
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"column:deleted_at;index"`
 }
 
 func (ModelPricing) TableName() string {
 	return "model_pricing"
 }
 
+// ModelPricingHistory is an append-only snapshot of a ModelPricing row,
+// written on every pricing change inside the same transaction as the
+// change itself, so a point-in-time cost can be reconstructed later
+// without mutating the live row.
+type ModelPricingHistory struct {
+	ID                    int64     `json:"id,omitzero" gorm:"primaryKey;autoIncrement"`
+	ModelID               int64     `json:"model_id" gorm:"column:model_id;index"`
+	PromptCost            string    `json:"prompt_cost" gorm:"column:prompt_cost"`
+	CompletionCost        string    `json:"completion_cost" gorm:"column:completion_cost"`
+	RequestCost           string    `json:"request_cost,omitzero" gorm:"column:request_cost"`
+	ImageCost             string    `json:"image_cost,omitzero" gorm:"column:image_cost"`
+	WebSearchCost         string    `json:"web_search_cost,omitzero" gorm:"column:web_search_cost"`
+	InternalReasoningCost string    `json:"internal_reasoning_cost,omitzero" gorm:"column:internal_reasoning_cost"`
+	RecordedAt            time.Time `json:"recorded_at" gorm:"column:recorded_at;index"`
+}
+
+func (ModelPricingHistory) TableName() string {
+	return "model_pricing_history"
+}
+
 // ModelArchitecture represents model architecture (database entity and API response)
 type ModelArchitecture struct {
 	ID           int64  `json:"id,omitzero" gorm:"primaryKey;autoIncrement"`
@@ -78,8 +103,22 @@ type ModelEndpoint struct {
 	SupportsImplicitCaching string `json:"supports_implicit_caching,omitzero" gorm:"column:supports_implicit_caching"` // stored as string "true"/"false"
 	IsZDR                   string `json:"is_zdr,omitzero" gorm:"column:is_zdr"`                                       // stored as string "true"/"false"
 
+	// LastCheckedAt and Message are written by the background health
+	// Scheduler (internal/health); Status doubles as the probe result
+	// (0 = healthy, matching the pre-existing "status = 0" = active
+	// convention used throughout List/Provider filtering), and is
+	// otherwise whatever Upsert's caller supplied. A catalog-driven caller
+	// that doesn't carry real probe data should set
+	// UpsertOptions.PreserveEndpointHealth so its resync leaves all three
+	// alone instead of overwriting the Scheduler's latest probe with zero
+	// values (see reconcileProviders' Omit).
+	LastCheckedAt *time.Time `json:"last_checked_at,omitzero" gorm:"column:last_checked_at"`
+	Message       string     `json:"message,omitzero" gorm:"column:message"`
+
 	// Relationships
 	Pricing *ModelEndpointPricing `json:"pricing,omitzero" gorm:"foreignKey:EndpointID"`
+
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"column:deleted_at;index"`
 }
 
 func (ModelEndpoint) TableName() string {
@@ -100,12 +139,36 @@ type ModelEndpointPricing struct {
 	InputCacheReadCost  string `json:"input_cache_read_cost,omitzero" gorm:"column:input_cache_read_cost"`
 	InputCacheWriteCost string `json:"input_cache_write_cost,omitzero" gorm:"column:input_cache_write_cost"`
 	Discount            string `json:"discount,omitzero" gorm:"column:discount"`
+
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"column:deleted_at;index"`
 }
 
 func (ModelEndpointPricing) TableName() string {
 	return "model_endpoint_pricing"
 }
 
+// ModelEndpointPricingHistory is the ModelEndpoint analogue of
+// ModelPricingHistory, snapshotting a single endpoint's pricing.
+type ModelEndpointPricingHistory struct {
+	ID                  int64     `json:"id,omitzero" gorm:"primaryKey;autoIncrement"`
+	EndpointID          int64     `json:"endpoint_id" gorm:"column:endpoint_id;index"`
+	PromptCost          string    `json:"prompt_cost,omitzero" gorm:"column:prompt_cost"`
+	CompletionCost      string    `json:"completion_cost,omitzero" gorm:"column:completion_cost"`
+	RequestCost         string    `json:"request_cost,omitzero" gorm:"column:request_cost"`
+	ImageCost           string    `json:"image_cost,omitzero" gorm:"column:image_cost"`
+	ImageOutputCost     string    `json:"image_output_cost,omitzero" gorm:"column:image_output_cost"`
+	AudioCost           string    `json:"audio_cost,omitzero" gorm:"column:audio_cost"`
+	InputAudioCacheCost string    `json:"input_audio_cache_cost,omitzero" gorm:"column:input_audio_cache_cost"`
+	InputCacheReadCost  string    `json:"input_cache_read_cost,omitzero" gorm:"column:input_cache_read_cost"`
+	InputCacheWriteCost string    `json:"input_cache_write_cost,omitzero" gorm:"column:input_cache_write_cost"`
+	Discount            string    `json:"discount,omitzero" gorm:"column:discount"`
+	RecordedAt          time.Time `json:"recorded_at" gorm:"column:recorded_at;index"`
+}
+
+func (ModelEndpointPricingHistory) TableName() string {
+	return "model_endpoint_pricing_history"
+}
+
 // ModelSupportedParameter represents supported parameters (many-to-many with Model)
 type ModelSupportedParameter struct {
 	ID            int64              `json:"id,omitzero" gorm:"primaryKey;autoIncrement"`
@@ -121,7 +184,7 @@ func (ModelSupportedParameter) TableName() string {
 type ModelDefaultParameters struct {
 	ID         int64                   `json:"id,omitzero" gorm:"primaryKey;autoIncrement"`
 	ModelID    int64                   `json:"model_id,omitzero" gorm:"column:model_id;uniqueIndex"`
-	Parameters DefaultParametersValues `json:"parameters" gorm:"column:parameters;type:jsonb;serializer:json"`
+	Parameters DefaultParametersValues `json:"parameters" gorm:"column:parameters;serializer:json"`
 }
 
 // DefaultParametersValues contains the strongly typed default parameter values
@@ -155,16 +218,26 @@ func (ModelDefaultParameters) TableName() string {
 	return "model_default_parameters"
 }
 
+// GormDBDataType picks JSONB on Postgres and plain JSON elsewhere, since
+// SQLite and MySQL don't have a native jsonb column type.
+func (DefaultParametersValues) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	if db.Dialector.Name() == "postgres" {
+		return "JSONB"
+	}
+	return "JSON"
+}
+
 // Model represents the core LLM model with normalized relationships
 type Model struct {
-	ID            int64     `json:"id" gorm:"primaryKey;autoIncrement"`
-	Author        string    `json:"author" gorm:"column:author;index;uniqueIndex:idx_author_model"`
-	ModelName     string    `json:"model_name" gorm:"column:model_name;index;uniqueIndex:idx_author_model"`
-	DisplayName   string    `json:"display_name,omitzero" gorm:"column:display_name"`
-	Description   string    `json:"description,omitzero" gorm:"column:description"`
-	ContextLength int       `json:"context_length,omitzero" gorm:"column:context_length"`
-	CreatedAt     time.Time `json:"created_at" gorm:"column:created_at"`
-	LastUpdated   time.Time `json:"last_updated" gorm:"column:last_updated"`
+	ID            int64          `json:"id" gorm:"primaryKey;autoIncrement"`
+	Author        string         `json:"author" gorm:"column:author;index;uniqueIndex:idx_author_model"`
+	ModelName     string         `json:"model_name" gorm:"column:model_name;index;uniqueIndex:idx_author_model"`
+	DisplayName   string         `json:"display_name,omitzero" gorm:"column:display_name"`
+	Description   string         `json:"description,omitzero" gorm:"column:description"`
+	ContextLength int            `json:"context_length,omitzero" gorm:"column:context_length"`
+	CreatedAt     time.Time      `json:"created_at" gorm:"column:created_at"`
+	LastUpdated   time.Time      `json:"last_updated" gorm:"column:last_updated"`
+	DeletedAt     gorm.DeletedAt `json:"-" gorm:"column:deleted_at;index"`
 
 	// Normalized relationships
 	Pricing             *ModelPricing             `json:"pricing,omitzero" gorm:"foreignKey:ModelID"`
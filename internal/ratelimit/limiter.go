@@ -0,0 +1,36 @@
+// Package ratelimit provides request-rate limiting for the Fiber API:
+// a pluggable RateLimiter backend (in-process token bucket, or a
+// Redis-backed bucket shared across replicas) plus a middleware that
+// applies it per route group and emits the standard X-RateLimit-*
+// headers.
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// ConfigRateLimit caps callers to Limit requests per Interval. It's the
+// unit both the global default and any per-route-group override are
+// expressed in.
+type ConfigRateLimit struct {
+	Limit    int
+	Interval time.Duration
+}
+
+// Result reports the outcome of a single Allow check, enough to
+// populate the X-RateLimit-* response headers regardless of which
+// RateLimiter produced it.
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	ResetAt   time.Time
+}
+
+// RateLimiter decides whether the caller identified by key may make
+// another request under cfg. Implementations must be safe for
+// concurrent use, since Allow is called from every matching request.
+type RateLimiter interface {
+	Allow(ctx context.Context, key string, cfg ConfigRateLimit) (Result, error)
+}
@@ -0,0 +1,112 @@
+package ratelimit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// apiKeyHeader is the header a caller presents an API key in. Its
+// presence, not its validity, is what selects the Authenticated bucket
+// below — Middleware rate-limits, it doesn't authenticate.
+const apiKeyHeader = "X-API-Key"
+
+// Options configures one Middleware instance.
+type Options struct {
+	// Resolve picks the bucket name and limits to apply to a request: a
+	// name identifying which rate-limit scope it falls under (e.g. a
+	// route-group prefix, or "global"), plus the limit for anonymous
+	// callers and the limit for callers presenting an API key. A nil
+	// Resolve applies a single global scope with zero-value limits,
+	// which RateLimiter implementations should treat as "always deny" —
+	// callers should always set this.
+	Resolve func(c *fiber.Ctx) (bucket string, anonymous, authenticated ConfigRateLimit)
+	// KeyFunc identifies the caller within whichever bucket applies. A
+	// nil KeyFunc defaults to apiKeyOrIP.
+	KeyFunc func(c *fiber.Ctx) string
+}
+
+// Middleware builds a Fiber handler that checks limiter before calling
+// c.Next(), setting X-RateLimit-Limit/Remaining/Reset on every response
+// and rejecting with 429 plus Retry-After once the caller's bucket is
+// exhausted. A limiter error fails open (the request proceeds, logged),
+// since an unreachable rate-limit backend shouldn't take the whole API
+// down with it.
+//
+// Only one Options.Resolve call (and therefore one RateLimiter.Allow
+// call) happens per request: mounting Middleware more than once for the
+// same request path would have each instance spend a token against the
+// same underlying bucket for overlapping scopes, silently combining
+// limits that were meant to apply independently. Callers that need
+// per-route overrides should express them inside a single Resolve
+// function instead, as registerRateLimit in internal/api does.
+func Middleware(limiter RateLimiter, opts Options) fiber.Handler {
+	keyFunc := opts.KeyFunc
+	if keyFunc == nil {
+		keyFunc = apiKeyOrIP
+	}
+	resolve := opts.Resolve
+	if resolve == nil {
+		resolve = func(*fiber.Ctx) (string, ConfigRateLimit, ConfigRateLimit) {
+			return "global", ConfigRateLimit{}, ConfigRateLimit{}
+		}
+	}
+
+	return func(c *fiber.Ctx) error {
+		bucket, anonymous, authenticated := resolve(c)
+
+		cfg := anonymous
+		if c.Get(apiKeyHeader) != "" {
+			cfg = authenticated
+		}
+
+		// The bucket name scopes the key so a caller's allowance on one
+		// route group is independent of their allowance on another,
+		// even though keyFunc(c) alone is the same for both.
+		key := bucket + ":" + keyFunc(c)
+
+		result, err := limiter.Allow(c.UserContext(), key, cfg)
+		if err != nil {
+			log.Printf("ratelimit: %v", err)
+			return c.Next()
+		}
+
+		c.Set("X-RateLimit-Limit", strconv.Itoa(result.Limit))
+		c.Set("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		c.Set("X-RateLimit-Reset", strconv.FormatInt(result.ResetAt.Unix(), 10))
+
+		if !result.Allowed {
+			retryAfter := int(time.Until(result.ResetAt).Seconds())
+			if retryAfter < 0 {
+				retryAfter = 0
+			}
+			c.Set(fiber.HeaderRetryAfter, strconv.Itoa(retryAfter))
+			return c.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+				"status":  fiber.StatusTooManyRequests,
+				"error":   "rate limit exceeded",
+				"success": false,
+			})
+		}
+
+		return c.Next()
+	}
+}
+
+// apiKeyOrIP buckets authenticated callers by their API key and
+// everyone else by client IP. The key is hashed rather than embedded
+// verbatim, since RedisLimiter folds this value straight into its Redis
+// key names — anyone with read access to that keyspace (KEYS/SCAN,
+// MONITOR, backups) would otherwise recover live API keys directly from
+// key names, following the same sha256-of-identity convention
+// repository.cacheKey uses for its own derived keys.
+func apiKeyOrIP(c *fiber.Ctx) string {
+	if key := c.Get(apiKeyHeader); key != "" {
+		sum := sha256.Sum256([]byte(key))
+		return "key:" + hex.EncodeToString(sum[:])
+	}
+	return "ip:" + c.IP()
+}
@@ -0,0 +1,55 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisLimiter is a RateLimiter backed by a shared Redis fixed-window
+// counter, so multiple replicas enforce one combined limit rather than
+// each getting their own in-process allowance. It trades the in-process
+// TokenBucketLimiter's smooth continuous refill for a simpler window
+// that Redis's atomic INCR can implement without a Lua script.
+type RedisLimiter struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisLimiter constructs a RedisLimiter against client, namespacing
+// its keys under prefix so it can share a Redis instance with other
+// subsystems.
+func NewRedisLimiter(client *redis.Client, prefix string) *RedisLimiter {
+	return &RedisLimiter{client: client, prefix: prefix}
+}
+
+// Allow increments the counter for key's current cfg.Interval-sized
+// window, setting its expiry on first use so stale windows don't linger.
+func (l *RedisLimiter) Allow(ctx context.Context, key string, cfg ConfigRateLimit) (Result, error) {
+	windowStart := time.Now().Truncate(cfg.Interval)
+	resetAt := windowStart.Add(cfg.Interval)
+	windowKey := fmt.Sprintf("%s:%s:%d", l.prefix, key, windowStart.Unix())
+
+	count, err := l.client.Incr(ctx, windowKey).Result()
+	if err != nil {
+		return Result{}, fmt.Errorf("incr %s: %w", windowKey, err)
+	}
+	if count == 1 {
+		if err := l.client.Expire(ctx, windowKey, cfg.Interval).Err(); err != nil {
+			return Result{}, fmt.Errorf("expire %s: %w", windowKey, err)
+		}
+	}
+
+	remaining := cfg.Limit - int(count)
+	if remaining < 0 {
+		remaining = 0
+	}
+	return Result{
+		Allowed:   int(count) <= cfg.Limit,
+		Limit:     cfg.Limit,
+		Remaining: remaining,
+		ResetAt:   resetAt,
+	}, nil
+}
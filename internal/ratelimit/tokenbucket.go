@@ -0,0 +1,104 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// idleTTL is how long a bucket may sit unused before Run's janitor
+// reclaims it. Buckets are keyed per caller (API key or IP), so without
+// this a long-lived process would accumulate one entry per distinct
+// caller forever.
+const idleTTL = 10 * time.Minute
+
+type bucket struct {
+	tokens     float64
+	lastRefill time.Time
+}
+
+// TokenBucketLimiter is an in-process RateLimiter: each key gets its own
+// token bucket that refills continuously at cfg.Limit/cfg.Interval
+// tokens per second, up to cfg.Limit tokens. It's the right choice for a
+// single replica; for multiple replicas sharing one limit, use
+// RedisLimiter instead.
+type TokenBucketLimiter struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+}
+
+// NewTokenBucketLimiter constructs an empty TokenBucketLimiter. Callers
+// should also start Run in a goroutine to reclaim idle buckets.
+func NewTokenBucketLimiter() *TokenBucketLimiter {
+	return &TokenBucketLimiter{buckets: make(map[string]*bucket)}
+}
+
+// Allow refills key's bucket for the elapsed time since its last check,
+// then spends one token if available.
+func (l *TokenBucketLimiter) Allow(ctx context.Context, key string, cfg ConfigRateLimit) (Result, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(cfg.Limit), lastRefill: now}
+		l.buckets[key] = b
+	}
+
+	refillRate := float64(cfg.Limit) / cfg.Interval.Seconds()
+	elapsed := now.Sub(b.lastRefill).Seconds()
+	b.tokens = math.Min(float64(cfg.Limit), b.tokens+elapsed*refillRate)
+	b.lastRefill = now
+
+	allowed := b.tokens >= 1
+	if allowed {
+		b.tokens--
+	}
+
+	// secondsToNextToken is how long until at least one token is
+	// available again, which is what a Retry-After/X-RateLimit-Reset
+	// header should reflect — not how long until the bucket refills to
+	// full capacity, which could overstate the wait by up to an entire
+	// Interval.
+	var secondsToNextToken float64
+	if b.tokens < 1 {
+		secondsToNextToken = (1 - b.tokens) / refillRate
+	}
+	return Result{
+		Allowed:   allowed,
+		Limit:     cfg.Limit,
+		Remaining: int(b.tokens),
+		ResetAt:   now.Add(time.Duration(secondsToNextToken * float64(time.Second))),
+	}, nil
+}
+
+// Run periodically reclaims buckets idle longer than idleTTL, until ctx
+// is canceled. Unlike services.SyncWorker/health.Scheduler it does not
+// run immediately on start: the first sweep happens one idleTTL after
+// Run is called, since nothing is idle yet at startup.
+func (l *TokenBucketLimiter) Run(ctx context.Context) {
+	ticker := time.NewTicker(idleTTL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			l.sweep()
+		}
+	}
+}
+
+func (l *TokenBucketLimiter) sweep() {
+	cutoff := time.Now().Add(-idleTTL)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for key, b := range l.buckets {
+		if b.lastRefill.Before(cutoff) {
+			delete(l.buckets, key)
+		}
+	}
+}
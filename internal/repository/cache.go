@@ -0,0 +1,284 @@
+package repository
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/adaptive/adaptive-model-registry/internal/models"
+)
+
+// cacheNamespace is the single invalidation unit for the model cache:
+// since List's joins make it impractical to know which cached entries a
+// given Upsert affects, a write simply drops every entry in this
+// namespace rather than attempting fine-grained invalidation.
+const cacheNamespace = "models"
+
+type noCacheKey struct{}
+
+// WithNoCache returns a context that instructs a CachedModelRepository to
+// bypass its cache for this call, honoring a request sent with
+// "Cache-Control: no-cache".
+func WithNoCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noCacheKey{}, true)
+}
+
+func bypassCache(ctx context.Context) bool {
+	v, _ := ctx.Value(noCacheKey{}).(bool)
+	return v
+}
+
+// CacheStats reports cumulative counters for a cache-backed repository.
+type CacheStats struct {
+	Hits     uint64 `json:"hits"`
+	Misses   uint64 `json:"misses"`
+	Evicts   uint64 `json:"evicts"`
+	Size     int    `json:"size"`
+	Capacity int    `json:"capacity"`
+}
+
+// CacheStatter is implemented by cache-backed repositories to expose
+// hit/miss/evict counters, e.g. for a /debug/cache/stats endpoint or a
+// health sub-check.
+type CacheStatter interface {
+	Stats() CacheStats
+}
+
+// lruCache is a bounded, TTL-aware cache used to memoize hot reads in
+// front of the repository layer, following the same shape as xorm's
+// second-level cache: a fixed entry-count LRU plus an expiry per entry.
+type lruCache struct {
+	mu       sync.Mutex
+	capacity int
+	ttl      time.Duration
+	index    map[string]*list.Element
+	order    *list.List // front = most recently used
+
+	hits, misses, evicts uint64
+}
+
+type cacheEntry struct {
+	key       string
+	value     interface{}
+	expiresAt time.Time
+}
+
+func newLRUCache(capacity int, ttl time.Duration) *lruCache {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	return &lruCache{
+		capacity: capacity,
+		ttl:      ttl,
+		index:    make(map[string]*list.Element, capacity),
+		order:    list.New(),
+	}
+}
+
+func (c *lruCache) get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.index[key]
+	if !ok {
+		c.misses++
+		return nil, false
+	}
+
+	entry := elem.Value.(*cacheEntry)
+	if c.ttl > 0 && time.Now().After(entry.expiresAt) {
+		c.removeLocked(elem)
+		c.misses++
+		return nil, false
+	}
+
+	c.order.MoveToFront(elem)
+	c.hits++
+	return entry.value, true
+}
+
+func (c *lruCache) set(key string, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.index[key]; ok {
+		entry := elem.Value.(*cacheEntry)
+		entry.value = value
+		entry.expiresAt = c.expiry()
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	elem := c.order.PushFront(&cacheEntry{key: key, value: value, expiresAt: c.expiry()})
+	c.index[key] = elem
+
+	for c.order.Len() > c.capacity {
+		c.removeLocked(c.order.Back())
+		c.evicts++
+	}
+}
+
+func (c *lruCache) expiry() time.Time {
+	if c.ttl <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(c.ttl)
+}
+
+// removeLocked removes elem; callers must hold c.mu.
+func (c *lruCache) removeLocked(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.index, elem.Value.(*cacheEntry).key)
+}
+
+// purge drops every cached entry, used to invalidate the whole namespace
+// after a write.
+func (c *lruCache) purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.index = make(map[string]*list.Element, c.capacity)
+	c.order.Init()
+}
+
+func (c *lruCache) stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return CacheStats{
+		Hits:     c.hits,
+		Misses:   c.misses,
+		Evicts:   c.evicts,
+		Size:     c.order.Len(),
+		Capacity: c.capacity,
+	}
+}
+
+// CachedModelRepository decorates a ModelRepository with an LRU cache in
+// front of its hottest reads, List and GetByProviderAndName.
+type CachedModelRepository struct {
+	ModelRepository
+	cache *lruCache
+}
+
+// NewCachedModelRepository wraps repo with an LRU cache of the given size
+// and per-entry TTL (zero TTL means entries never expire on their own).
+func NewCachedModelRepository(repo ModelRepository, size int, ttl time.Duration) *CachedModelRepository {
+	return &CachedModelRepository{
+		ModelRepository: repo,
+		cache:           newLRUCache(size, ttl),
+	}
+}
+
+func (r *CachedModelRepository) List(ctx context.Context, filter models.ModelFilter) ([]models.Model, error) {
+	if bypassCache(ctx) {
+		return r.ModelRepository.List(ctx, filter)
+	}
+
+	key, err := cacheKey("list", filter)
+	if err != nil {
+		return r.ModelRepository.List(ctx, filter)
+	}
+
+	if cached, ok := r.cache.get(key); ok {
+		return cached.([]models.Model), nil
+	}
+
+	items, err := r.ModelRepository.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	r.cache.set(key, items)
+	return items, nil
+}
+
+func (r *CachedModelRepository) GetByProviderAndName(ctx context.Context, provider, name string) (*models.Model, error) {
+	if bypassCache(ctx) {
+		return r.ModelRepository.GetByProviderAndName(ctx, provider, name)
+	}
+
+	key, err := cacheKey("get", provider, name)
+	if err != nil {
+		return r.ModelRepository.GetByProviderAndName(ctx, provider, name)
+	}
+
+	if cached, ok := r.cache.get(key); ok {
+		return cached.(*models.Model), nil
+	}
+
+	item, err := r.ModelRepository.GetByProviderAndName(ctx, provider, name)
+	if err != nil {
+		return nil, err
+	}
+	r.cache.set(key, item)
+	return item, nil
+}
+
+func (r *CachedModelRepository) Upsert(ctx context.Context, model *models.Model, opts ...UpsertOptions) (*models.Model, error) {
+	result, err := r.ModelRepository.Upsert(ctx, model, opts...)
+	if err == nil {
+		r.cache.purge()
+	}
+	return result, err
+}
+
+func (r *CachedModelRepository) SoftDelete(ctx context.Context, provider, name string) error {
+	err := r.ModelRepository.SoftDelete(ctx, provider, name)
+	if err == nil {
+		r.cache.purge()
+	}
+	return err
+}
+
+func (r *CachedModelRepository) Restore(ctx context.Context, provider, name string) (*models.Model, error) {
+	result, err := r.ModelRepository.Restore(ctx, provider, name)
+	if err == nil {
+		r.cache.purge()
+	}
+	return result, err
+}
+
+func (r *CachedModelRepository) UpdateEndpointHealth(ctx context.Context, endpointID int64, status int, message string, checkedAt time.Time) error {
+	err := r.ModelRepository.UpdateEndpointHealth(ctx, endpointID, status, message, checkedAt)
+	if err == nil {
+		r.cache.purge()
+	}
+	return err
+}
+
+// UpdateEndpointHealthBatch purges the cache once for the entire batch,
+// rather than once per update the way repeated UpdateEndpointHealth calls
+// would: the health Scheduler probes every endpoint in a cycle through
+// this path specifically so N endpoints don't cost N purges of the List/
+// GetByProviderAndName cache per HealthCheckInterval.
+//
+// It purges regardless of whether err is nil: UpdateEndpointHealthBatch
+// continues past individual failures, so a non-nil err here can still
+// mean most of the batch wrote successfully, and skipping the purge
+// would leave those endpoints' cached entries stale.
+func (r *CachedModelRepository) UpdateEndpointHealthBatch(ctx context.Context, updates []EndpointHealthUpdate) error {
+	err := r.ModelRepository.UpdateEndpointHealthBatch(ctx, updates)
+	if len(updates) > 0 {
+		r.cache.purge()
+	}
+	return err
+}
+
+// Stats reports the cache's hit/miss/evict counters.
+func (r *CachedModelRepository) Stats() CacheStats {
+	return r.cache.stats()
+}
+
+// cacheKey derives a stable cache key from the namespace and an arbitrary
+// set of parts (a filter struct, or scalar lookup arguments).
+func cacheKey(parts ...interface{}) (string, error) {
+	b, err := json.Marshal(parts)
+	if err != nil {
+		return "", fmt.Errorf("cache key: %w", err)
+	}
+	sum := sha256.Sum256(b)
+	return cacheNamespace + ":" + hex.EncodeToString(sum[:]), nil
+}
@@ -2,7 +2,12 @@ package repository
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/adaptive/adaptive-model-registry/internal/models"
@@ -12,11 +17,119 @@ import (
 // ErrNotFound signals that no record matched the query.
 var ErrNotFound = gorm.ErrRecordNotFound
 
+// UpsertMode selects how Upsert reconciles an existing model's child
+// relationships (Pricing, Architecture, TopProvider, SupportedParameters,
+// DefaultParameters, Providers) against the incoming payload.
+type UpsertMode int
+
+const (
+	// UpsertReplace removes any child row absent from the incoming
+	// payload, so the payload fully describes the model afterward. This
+	// is the default.
+	UpsertReplace UpsertMode = iota
+	// UpsertMerge leaves child rows the incoming payload doesn't mention
+	// untouched, only applying the creates/updates it does mention.
+	UpsertMerge
+)
+
+// UpsertOptions configures Upsert's reconciliation behavior.
+type UpsertOptions struct {
+	Mode UpsertMode
+	// PreserveEndpointHealth, when true, makes reconcileProviders leave an
+	// existing endpoint's Status/Message/LastCheckedAt exactly as stored
+	// instead of overwriting them with the incoming payload's values.
+	// Catalog-driven callers that don't carry real probe data (currently
+	// sync.Scheduler and SyncWorker) should set this so a resync can't
+	// clobber what the health Scheduler already wrote; a direct API call
+	// intending to set an endpoint's Status itself should leave it false.
+	PreserveEndpointHealth bool
+}
+
+func resolveUpsertOptions(opts []UpsertOptions) UpsertOptions {
+	if len(opts) > 0 {
+		return opts[0]
+	}
+	return UpsertOptions{Mode: UpsertReplace}
+}
+
 // ModelRepository defines persistence operations for model metadata.
 type ModelRepository interface {
 	List(ctx context.Context, filter models.ModelFilter) ([]models.Model, error)
+	// ListPage returns a single page of List's result, ordered by
+	// (model_name, id), for row-by-row streaming consumers.
+	ListPage(ctx context.Context, filter models.ModelFilter, cursor *StreamCursor, limit int) ([]models.Model, error)
+	// EstimateCount returns an approximate row count for filter, cheap
+	// enough to call per streaming request.
+	EstimateCount(ctx context.Context, filter models.ModelFilter) (int64, error)
 	GetByProviderAndName(ctx context.Context, provider, name string) (*models.Model, error)
-	Upsert(ctx context.Context, model *models.Model) (*models.Model, error)
+	// Upsert creates a new model, or reconciles an existing one's child
+	// relationships against model's payload per opts (full replace by
+	// default when opts is omitted).
+	Upsert(ctx context.Context, model *models.Model, opts ...UpsertOptions) (*models.Model, error)
+	// SoftDelete marks the model identified by (provider, name) as
+	// deleted without removing its row, so List/GetByProviderAndName stop
+	// returning it by default.
+	SoftDelete(ctx context.Context, provider, name string) error
+	// Restore clears a previous SoftDelete, returning the model as it
+	// stands afterward.
+	Restore(ctx context.Context, provider, name string) (*models.Model, error)
+	// History reconstructs the model identified by (provider, name) as it
+	// existed at instant at, using the pricing history trail for
+	// point-in-time costs.
+	History(ctx context.Context, provider, name string, at time.Time) (*models.Model, error)
+	// ListEndpoints returns every non-deleted ModelEndpoint across all
+	// models, for the health Scheduler to probe.
+	ListEndpoints(ctx context.Context) ([]models.ModelEndpoint, error)
+	// UpdateEndpointHealth records the outcome of a health probe against
+	// a single endpoint, without touching any other column.
+	UpdateEndpointHealth(ctx context.Context, endpointID int64, status int, message string, checkedAt time.Time) error
+	// UpdateEndpointHealthBatch applies updates the same way
+	// UpdateEndpointHealth would, one at a time, but as a single
+	// invalidation unit: a cache decorator purges once for the whole
+	// batch instead of once per update, which matters when a caller (the
+	// health Scheduler) records an entire probe cycle's worth of
+	// endpoints together.
+	UpdateEndpointHealthBatch(ctx context.Context, updates []EndpointHealthUpdate) error
+}
+
+// EndpointHealthUpdate is one endpoint's health-probe outcome, for
+// UpdateEndpointHealthBatch.
+type EndpointHealthUpdate struct {
+	EndpointID int64
+	Status     int
+	Message    string
+	CheckedAt  time.Time
+}
+
+// StreamCursor identifies the last row of a previously streamed page,
+// matching the deterministic (model_name, id) order List/ListPage use.
+type StreamCursor struct {
+	LastID        int64
+	LastModelName string
+}
+
+// EncodeStreamCursor serializes c into the opaque cursor handed back to
+// clients (e.g. in a Link header).
+func EncodeStreamCursor(c StreamCursor) string {
+	raw := fmt.Sprintf("%s\x00%d", c.LastModelName, c.LastID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeStreamCursor parses a cursor produced by EncodeStreamCursor.
+func DecodeStreamCursor(cursor string) (StreamCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return StreamCursor{}, fmt.Errorf("decode cursor: %w", err)
+	}
+	parts := strings.SplitN(string(raw), "\x00", 2)
+	if len(parts) != 2 {
+		return StreamCursor{}, errors.New("decode cursor: malformed")
+	}
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return StreamCursor{}, fmt.Errorf("decode cursor: %w", err)
+	}
+	return StreamCursor{LastModelName: parts[0], LastID: id}, nil
 }
 
 type modelRepository struct {
@@ -30,7 +143,21 @@ func NewModelRepository(db *gorm.DB) ModelRepository {
 
 func (r *modelRepository) List(ctx context.Context, filter models.ModelFilter) ([]models.Model, error) {
 	var items []models.Model
-	query := r.db.WithContext(ctx).
+	if err := r.filteredQuery(ctx, filter).Find(&items).Error; err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// filteredQuery builds the joined/preloaded, filtered query shared by
+// List, ListPage, and EstimateCount.
+func (r *modelRepository) filteredQuery(ctx context.Context, filter models.ModelFilter) *gorm.DB {
+	query := r.db.WithContext(ctx)
+	if filter.IncludeDeleted != nil && *filter.IncludeDeleted {
+		query = query.Unscoped()
+	}
+
+	query = query.
 		Joins("Pricing").
 		Joins("Architecture").
 		Joins("TopProvider").
@@ -141,12 +268,86 @@ func (r *modelRepository) List(ctx context.Context, filter models.ModelFilter) (
 		query = query.Where("llm_models.id IN (?)", subQuery).Distinct()
 	}
 
-	if err := query.Find(&items).Error; err != nil {
+	// Filter by endpoints the health Scheduler observed healthy recently
+	if filter.HealthyWithinMinutes != nil {
+		cutoff := time.Now().Add(-time.Duration(*filter.HealthyWithinMinutes) * time.Minute)
+		subQuery := query.Session(&gorm.Session{NewDB: true}).
+			Select("llm_models.id").
+			Joins("JOIN model_endpoints ON model_endpoints.model_id = llm_models.id").
+			Where("model_endpoints.status = 0").
+			Where("model_endpoints.last_checked_at >= ?", cutoff)
+
+		query = query.Where("llm_models.id IN (?)", subQuery).Distinct()
+	}
+
+	return query
+}
+
+// ListPage returns a single page of List's filtered result set, ordered
+// by (model_name, id) and bounded by limit. When cursor is non-nil, only
+// rows after it are returned. Keyset pagination here is expressed as a
+// portable OR-expansion rather than a row-constructor comparison so it
+// works unchanged across the Postgres/MySQL/SQLite drivers database.Open
+// can open.
+//
+// This uses Find rather than GORM's Rows/ScanRows: filteredQuery's
+// Preload("Architecture.Modalities")/Preload("SupportedParameters")/
+// Preload("Providers")/Preload("Providers.Pricing") each run as their own
+// follow-up query against the page's model IDs and get assembled back
+// into models.Model by GORM's ORM layer - ScanRows only populates the
+// columns of the single result set it's handed, so it can't reproduce
+// that assembly without hand-rolling the preloads' joins and grouping
+// here instead.
+func (r *modelRepository) ListPage(ctx context.Context, filter models.ModelFilter, cursor *StreamCursor, limit int) ([]models.Model, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+
+	query := r.filteredQuery(ctx, filter).Order("llm_models.id")
+	if cursor != nil {
+		query = query.Where(
+			"llm_models.model_name > ? OR (llm_models.model_name = ? AND llm_models.id > ?)",
+			cursor.LastModelName, cursor.LastModelName, cursor.LastID,
+		)
+	}
+
+	var items []models.Model
+	if err := query.Limit(limit).Find(&items).Error; err != nil {
 		return nil, err
 	}
 	return items, nil
 }
 
+var explainRowsPattern = regexp.MustCompile(`rows=(\d+)`)
+
+// EstimateCount returns the Postgres query planner's row estimate for
+// filter via EXPLAIN, which is far cheaper than COUNT(*) once joins and
+// preloads make the query non-trivial. Non-Postgres dialects return 0
+// (unknown), since EXPLAIN's output format isn't portable across drivers.
+func (r *modelRepository) EstimateCount(ctx context.Context, filter models.ModelFilter) (int64, error) {
+	if r.db.Dialector.Name() != "postgres" {
+		return 0, nil
+	}
+
+	stmt := r.filteredQuery(ctx, filter).Session(&gorm.Session{DryRun: true}).Find(&[]models.Model{}).Statement
+
+	var rows []struct {
+		QueryPlan string `gorm:"column:QUERY PLAN"`
+	}
+	if err := r.db.WithContext(ctx).Raw("EXPLAIN "+stmt.SQL.String(), stmt.Vars...).Scan(&rows).Error; err != nil {
+		return 0, err
+	}
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	match := explainRowsPattern.FindStringSubmatch(rows[0].QueryPlan)
+	if match == nil {
+		return 0, nil
+	}
+	return strconv.ParseInt(match[1], 10, 64)
+}
+
 func (r *modelRepository) GetByProviderAndName(ctx context.Context, provider, name string) (*models.Model, error) {
 	var m models.Model
 	if err := r.db.WithContext(ctx).
@@ -168,11 +369,16 @@ func (r *modelRepository) GetByProviderAndName(ctx context.Context, provider, na
 	return &m, nil
 }
 
-func (r *modelRepository) Upsert(ctx context.Context, input *models.Model) (*models.Model, error) {
+func (r *modelRepository) Upsert(ctx context.Context, input *models.Model, opts ...UpsertOptions) (*models.Model, error) {
 	now := time.Now().UTC()
+	opt := resolveUpsertOptions(opts)
 
+	// Unscoped so a soft-deleted model with this author/model_name is
+	// still found here; otherwise it would fall through to the create
+	// branch below and collide with idx_author_model.
 	var existing models.Model
 	err := r.db.WithContext(ctx).
+		Unscoped().
 		Where("author = ? AND model_name = ?", input.Author, input.ModelName).
 		First(&existing).Error
 	switch {
@@ -194,6 +400,9 @@ func (r *modelRepository) Upsert(ctx context.Context, input *models.Model) (*mod
 				if err := tx.Create(input.Pricing).Error; err != nil {
 					return err
 				}
+				if err := tx.Create(pricingHistoryOf(input.Pricing, now)).Error; err != nil {
+					return err
+				}
 			}
 
 			if input.Architecture != nil {
@@ -251,6 +460,9 @@ func (r *modelRepository) Upsert(ctx context.Context, input *models.Model) (*mod
 						if err := tx.Create(input.Providers[i].Pricing).Error; err != nil {
 							return err
 						}
+						if err := tx.Create(endpointPricingHistoryOf(input.Providers[i].Pricing, now)).Error; err != nil {
+							return err
+						}
 					}
 				}
 			}
@@ -262,8 +474,662 @@ func (r *modelRepository) Upsert(ctx context.Context, input *models.Model) (*mod
 		return nil, err
 
 	default:
-		// For now, updates are not supported in the normalized schema
-		// The sync script only inserts new models
-		return nil, errors.New("model updates not yet implemented for normalized schema")
+		// Existing model: reconcile each child collection against the
+		// incoming payload rather than create-or-fail. Unscoped so this
+		// also implicitly restores a soft-deleted model that a fresh
+		// Upsert brings back.
+		input.ID = existing.ID
+		input.CreatedAt = existing.CreatedAt
+		input.LastUpdated = now
+
+		return input, r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Unscoped().Model(&models.Model{}).
+				Where("id = ?", existing.ID).
+				Updates(map[string]interface{}{
+					"display_name":   input.DisplayName,
+					"description":    input.Description,
+					"context_length": input.ContextLength,
+					"last_updated":   now,
+					"deleted_at":     nil,
+				}).Error; err != nil {
+				return err
+			}
+
+			if err := r.reconcilePricing(tx, existing.ID, input.Pricing, now, opt); err != nil {
+				return err
+			}
+			if err := r.reconcileArchitecture(tx, existing.ID, input.Architecture, opt); err != nil {
+				return err
+			}
+			if err := r.reconcileTopProvider(tx, existing.ID, input.TopProvider, opt); err != nil {
+				return err
+			}
+			if err := r.reconcileSupportedParameters(tx, existing.ID, input.SupportedParameters, opt); err != nil {
+				return err
+			}
+			if err := r.reconcileDefaultParameters(tx, existing.ID, input.DefaultParameters, opt); err != nil {
+				return err
+			}
+			return r.reconcileProviders(tx, existing.ID, input.Providers, now, opt)
+		})
+	}
+}
+
+// reconcilePricing reconciles the model-level Pricing relationship
+// (a natural 1:1, so there's nothing to match by key), writing a
+// ModelPricingHistory row whenever the stored values actually change.
+func (r *modelRepository) reconcilePricing(tx *gorm.DB, modelID int64, incoming *models.ModelPricing, now time.Time, opt UpsertOptions) error {
+	// Unscoped so a soft-deleted model being reconciled (i.e. implicitly
+	// restored by this Upsert) finds its own soft-deleted pricing row
+	// instead of colliding with it via ModelPricing's model_id uniqueIndex.
+	var existing models.ModelPricing
+	err := tx.Unscoped().Where("model_id = ?", modelID).First(&existing).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		if incoming == nil {
+			return nil
+		}
+		incoming.ModelID = modelID
+		if err := tx.Create(incoming).Error; err != nil {
+			return err
+		}
+		return tx.Create(pricingHistoryOf(incoming, now)).Error
+
+	case err != nil:
+		return err
+
+	case incoming == nil:
+		if opt.Mode != UpsertReplace {
+			return nil
+		}
+		return tx.Delete(&existing).Error
+
+	default:
+		incoming.ID = existing.ID
+		incoming.ModelID = modelID
+		if pricingEqual(existing, *incoming) && !existing.DeletedAt.Valid {
+			return nil
+		}
+		if err := tx.Unscoped().Save(incoming).Error; err != nil {
+			return err
+		}
+		return tx.Create(pricingHistoryOf(incoming, now)).Error
+	}
+}
+
+func pricingEqual(a, b models.ModelPricing) bool {
+	return a.PromptCost == b.PromptCost &&
+		a.CompletionCost == b.CompletionCost &&
+		a.RequestCost == b.RequestCost &&
+		a.ImageCost == b.ImageCost &&
+		a.WebSearchCost == b.WebSearchCost &&
+		a.InternalReasoningCost == b.InternalReasoningCost
+}
+
+func pricingHistoryOf(p *models.ModelPricing, now time.Time) *models.ModelPricingHistory {
+	return &models.ModelPricingHistory{
+		ModelID:               p.ModelID,
+		PromptCost:            p.PromptCost,
+		CompletionCost:        p.CompletionCost,
+		RequestCost:           p.RequestCost,
+		ImageCost:             p.ImageCost,
+		WebSearchCost:         p.WebSearchCost,
+		InternalReasoningCost: p.InternalReasoningCost,
+		RecordedAt:            now,
+	}
+}
+
+// reconcileArchitecture reconciles the model-level Architecture 1:1
+// relationship and, underneath it, its Modalities collection.
+func (r *modelRepository) reconcileArchitecture(tx *gorm.DB, modelID int64, incoming *models.ModelArchitecture, opt UpsertOptions) error {
+	var existing models.ModelArchitecture
+	err := tx.Where("model_id = ?", modelID).First(&existing).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		if incoming == nil {
+			return nil
+		}
+		incoming.ModelID = modelID
+		if err := tx.Create(incoming).Error; err != nil {
+			return err
+		}
+		for i := range incoming.Modalities {
+			incoming.Modalities[i].ArchitectureID = incoming.ID
+		}
+		if len(incoming.Modalities) == 0 {
+			return nil
+		}
+		return tx.Create(&incoming.Modalities).Error
+
+	case err != nil:
+		return err
+
+	case incoming == nil:
+		if opt.Mode != UpsertReplace {
+			return nil
+		}
+		if err := tx.Where("architecture_id = ?", existing.ID).Delete(&models.ModelArchitectureModality{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&existing).Error
+
+	default:
+		incoming.ID = existing.ID
+		incoming.ModelID = modelID
+		if err := tx.Save(incoming).Error; err != nil {
+			return err
+		}
+		return r.reconcileModalities(tx, existing.ID, incoming.Modalities, opt)
+	}
+}
+
+// reconcileModalities matches rows by the natural key
+// (modality_type, modality_value): anything in incoming not already
+// stored is created; anything stored but absent from incoming is removed
+// under UpsertReplace, left alone under UpsertMerge.
+func (r *modelRepository) reconcileModalities(tx *gorm.DB, architectureID int64, incoming []models.ModelArchitectureModality, opt UpsertOptions) error {
+	var stored []models.ModelArchitectureModality
+	if err := tx.Where("architecture_id = ?", architectureID).Find(&stored).Error; err != nil {
+		return err
+	}
+
+	storedByKey := make(map[string]models.ModelArchitectureModality, len(stored))
+	for _, m := range stored {
+		storedByKey[modalityKey(m.ModalityType, m.ModalityValue)] = m
+	}
+
+	seen := make(map[string]bool, len(incoming))
+	for i := range incoming {
+		m := &incoming[i]
+		key := modalityKey(m.ModalityType, m.ModalityValue)
+		seen[key] = true
+		if existingM, ok := storedByKey[key]; ok {
+			m.ID = existingM.ID // matched on its full natural key; nothing else to update
+			continue
+		}
+		m.ID = 0
+		m.ArchitectureID = architectureID
+		if err := tx.Create(m).Error; err != nil {
+			return err
+		}
+	}
+
+	if opt.Mode != UpsertReplace {
+		return nil
+	}
+	for key, m := range storedByKey {
+		if seen[key] {
+			continue
+		}
+		if err := tx.Delete(&m).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func modalityKey(modalityType, modalityValue string) string {
+	return modalityType + "\x00" + modalityValue
+}
+
+// reconcileTopProvider reconciles the model-level TopProvider 1:1 relationship.
+func (r *modelRepository) reconcileTopProvider(tx *gorm.DB, modelID int64, incoming *models.ModelTopProvider, opt UpsertOptions) error {
+	var existing models.ModelTopProvider
+	err := tx.Where("model_id = ?", modelID).First(&existing).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		if incoming == nil {
+			return nil
+		}
+		incoming.ModelID = modelID
+		return tx.Create(incoming).Error
+
+	case err != nil:
+		return err
+
+	case incoming == nil:
+		if opt.Mode != UpsertReplace {
+			return nil
+		}
+		return tx.Delete(&existing).Error
+
+	default:
+		incoming.ID = existing.ID
+		incoming.ModelID = modelID
+		return tx.Save(incoming).Error
+	}
+}
+
+// reconcileSupportedParameters matches rows by the natural key
+// parameter_name.
+func (r *modelRepository) reconcileSupportedParameters(tx *gorm.DB, modelID int64, incoming []models.ModelSupportedParameter, opt UpsertOptions) error {
+	var stored []models.ModelSupportedParameter
+	if err := tx.Where("model_id = ?", modelID).Find(&stored).Error; err != nil {
+		return err
+	}
+
+	storedByName := make(map[models.SupportedParameter]models.ModelSupportedParameter, len(stored))
+	for _, p := range stored {
+		storedByName[p.ParameterName] = p
+	}
+
+	seen := make(map[models.SupportedParameter]bool, len(incoming))
+	for i := range incoming {
+		p := &incoming[i]
+		seen[p.ParameterName] = true
+		if existingP, ok := storedByName[p.ParameterName]; ok {
+			p.ID = existingP.ID
+			continue
+		}
+		p.ID = 0
+		p.ModelID = modelID
+		if err := tx.Create(p).Error; err != nil {
+			return err
+		}
+	}
+
+	if opt.Mode != UpsertReplace {
+		return nil
+	}
+	for name, p := range storedByName {
+		if seen[name] {
+			continue
+		}
+		if err := tx.Delete(&p).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reconcileDefaultParameters reconciles the model-level DefaultParameters
+// 1:1 relationship; its Parameters column is a JSON blob with no further
+// natural key to diff, so an update simply overwrites it wholesale.
+func (r *modelRepository) reconcileDefaultParameters(tx *gorm.DB, modelID int64, incoming *models.ModelDefaultParameters, opt UpsertOptions) error {
+	var existing models.ModelDefaultParameters
+	err := tx.Where("model_id = ?", modelID).First(&existing).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		if incoming == nil {
+			return nil
+		}
+		incoming.ModelID = modelID
+		return tx.Create(incoming).Error
+
+	case err != nil:
+		return err
+
+	case incoming == nil:
+		if opt.Mode != UpsertReplace {
+			return nil
+		}
+		return tx.Delete(&existing).Error
+
+	default:
+		incoming.ID = existing.ID
+		incoming.ModelID = modelID
+		return tx.Save(incoming).Error
+	}
+}
+
+// reconcileProviders matches endpoint rows by the natural key
+// (provider_name, tag), reconciling each matched endpoint's Pricing
+// underneath it.
+func (r *modelRepository) reconcileProviders(tx *gorm.DB, modelID int64, incoming []models.ModelEndpoint, now time.Time, opt UpsertOptions) error {
+	// Unscoped so a soft-deleted model being reconciled finds its own
+	// soft-deleted endpoints instead of recreating them as duplicates.
+	var stored []models.ModelEndpoint
+	if err := tx.Unscoped().Where("model_id = ?", modelID).Find(&stored).Error; err != nil {
+		return err
+	}
+
+	storedByKey := make(map[string]models.ModelEndpoint, len(stored))
+	for _, e := range stored {
+		storedByKey[endpointKey(e.ProviderName, e.Tag)] = e
+	}
+
+	seen := make(map[string]bool, len(incoming))
+	for i := range incoming {
+		ep := &incoming[i]
+		key := endpointKey(ep.ProviderName, ep.Tag)
+		seen[key] = true
+
+		if existingEp, ok := storedByKey[key]; ok {
+			ep.ID = existingEp.ID
+			ep.ModelID = modelID
+			// Omit Pricing: GORM's default auto-save-associations would
+			// otherwise try to create ep.Pricing (its ID is always 0 on an
+			// incoming payload) before reconcileEndpointPricing runs below,
+			// colliding with endpoint_id's unique index since the matched
+			// endpoint already has a pricing row.
+			omit := []string{"Pricing"}
+			if opt.PreserveEndpointHealth {
+				// A catalog-driven caller (see PreserveEndpointHealth) never
+				// carries a meaningful probe result, so also omit the health
+				// Scheduler's own columns (UpdateEndpointHealth) - otherwise
+				// saving ep here would overwrite the Scheduler's most recent
+				// write with zero values on every resync.
+				omit = append(omit, "Status", "LastCheckedAt", "Message")
+			}
+			if err := tx.Unscoped().Omit(omit...).Save(ep).Error; err != nil {
+				return err
+			}
+		} else {
+			ep.ID = 0
+			ep.ModelID = modelID
+			if err := tx.Create(ep).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := r.reconcileEndpointPricing(tx, ep.ID, ep.Pricing, now, opt); err != nil {
+			return err
+		}
+	}
+
+	if opt.Mode != UpsertReplace {
+		return nil
+	}
+	for key, e := range storedByKey {
+		if seen[key] {
+			continue
+		}
+		if err := tx.Where("endpoint_id = ?", e.ID).Delete(&models.ModelEndpointPricing{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&e).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func endpointKey(providerName, tag string) string {
+	return providerName + "\x00" + tag
+}
+
+// reconcileEndpointPricing is the per-endpoint analogue of
+// reconcilePricing, gated by opt.Mode the same way: a dropped pricing
+// entry is only removed under UpsertReplace.
+func (r *modelRepository) reconcileEndpointPricing(tx *gorm.DB, endpointID int64, incoming *models.ModelEndpointPricing, now time.Time, opt UpsertOptions) error {
+	var existing models.ModelEndpointPricing
+	err := tx.Unscoped().Where("endpoint_id = ?", endpointID).First(&existing).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		if incoming == nil {
+			return nil
+		}
+		incoming.EndpointID = endpointID
+		if err := tx.Create(incoming).Error; err != nil {
+			return err
+		}
+		return tx.Create(endpointPricingHistoryOf(incoming, now)).Error
+
+	case err != nil:
+		return err
+
+	case incoming == nil:
+		if opt.Mode != UpsertReplace {
+			return nil
+		}
+		return tx.Delete(&existing).Error
+
+	default:
+		incoming.ID = existing.ID
+		incoming.EndpointID = endpointID
+		if endpointPricingEqual(existing, *incoming) && !existing.DeletedAt.Valid {
+			return nil
+		}
+		if err := tx.Unscoped().Save(incoming).Error; err != nil {
+			return err
+		}
+		return tx.Create(endpointPricingHistoryOf(incoming, now)).Error
+	}
+}
+
+func endpointPricingEqual(a, b models.ModelEndpointPricing) bool {
+	return a.PromptCost == b.PromptCost &&
+		a.CompletionCost == b.CompletionCost &&
+		a.RequestCost == b.RequestCost &&
+		a.ImageCost == b.ImageCost &&
+		a.ImageOutputCost == b.ImageOutputCost &&
+		a.AudioCost == b.AudioCost &&
+		a.InputAudioCacheCost == b.InputAudioCacheCost &&
+		a.InputCacheReadCost == b.InputCacheReadCost &&
+		a.InputCacheWriteCost == b.InputCacheWriteCost &&
+		a.Discount == b.Discount
+}
+
+func endpointPricingHistoryOf(p *models.ModelEndpointPricing, now time.Time) *models.ModelEndpointPricingHistory {
+	return &models.ModelEndpointPricingHistory{
+		EndpointID:          p.EndpointID,
+		PromptCost:          p.PromptCost,
+		CompletionCost:      p.CompletionCost,
+		RequestCost:         p.RequestCost,
+		ImageCost:           p.ImageCost,
+		ImageOutputCost:     p.ImageOutputCost,
+		AudioCost:           p.AudioCost,
+		InputAudioCacheCost: p.InputAudioCacheCost,
+		InputCacheReadCost:  p.InputCacheReadCost,
+		InputCacheWriteCost: p.InputCacheWriteCost,
+		Discount:            p.Discount,
+		RecordedAt:          now,
+	}
+}
+
+// SoftDelete sets DeletedAt on the model identified by (provider, name) and
+// cascades to its pricing, endpoints, and endpoint pricing, leaving every
+// row in place for History/Restore.
+func (r *modelRepository) SoftDelete(ctx context.Context, provider, name string) error {
+	var existing models.Model
+	if err := r.db.WithContext(ctx).
+		Where("author = ? AND model_name = ?", provider, name).
+		First(&existing).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&models.Model{}, existing.ID).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("model_id = ?", existing.ID).Delete(&models.ModelPricing{}).Error; err != nil {
+			return err
+		}
+
+		var endpointIDs []int64
+		if err := tx.Model(&models.ModelEndpoint{}).Where("model_id = ?", existing.ID).Pluck("id", &endpointIDs).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("model_id = ?", existing.ID).Delete(&models.ModelEndpoint{}).Error; err != nil {
+			return err
+		}
+		if len(endpointIDs) > 0 {
+			if err := tx.Where("endpoint_id IN ?", endpointIDs).Delete(&models.ModelEndpointPricing{}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Restore clears DeletedAt for a previously soft-deleted model, cascading
+// to the pricing, endpoints, and endpoint pricing SoftDelete touched.
+func (r *modelRepository) Restore(ctx context.Context, provider, name string) (*models.Model, error) {
+	var existing models.Model
+	if err := r.db.WithContext(ctx).
+		Unscoped().
+		Where("author = ? AND model_name = ? AND deleted_at IS NOT NULL", provider, name).
+		First(&existing).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Model(&models.Model{}).Where("id = ?", existing.ID).Update("deleted_at", nil).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Model(&models.ModelPricing{}).Where("model_id = ?", existing.ID).Update("deleted_at", nil).Error; err != nil {
+			return err
+		}
+
+		var endpointIDs []int64
+		if err := tx.Unscoped().Model(&models.ModelEndpoint{}).Where("model_id = ?", existing.ID).Pluck("id", &endpointIDs).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Model(&models.ModelEndpoint{}).Where("model_id = ?", existing.ID).Update("deleted_at", nil).Error; err != nil {
+			return err
+		}
+		if len(endpointIDs) > 0 {
+			if err := tx.Unscoped().Model(&models.ModelEndpointPricing{}).Where("endpoint_id IN ?", endpointIDs).Update("deleted_at", nil).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByProviderAndName(ctx, provider, name)
+}
+
+// History reconstructs the model identified by (provider, name) as it
+// stood at instant at. Only pricing is historized, so prompt/completion
+// costs come from the model_pricing[_endpoint]_history trail while every
+// other field reflects the current (possibly soft-deleted) row.
+func (r *modelRepository) History(ctx context.Context, provider, name string, at time.Time) (*models.Model, error) {
+	var m models.Model
+	err := r.db.WithContext(ctx).
+		Unscoped().
+		Preload("Architecture").
+		Preload("Architecture.Modalities").
+		Preload("TopProvider").
+		Preload("SupportedParameters").
+		Preload("DefaultParameters").
+		Preload("Providers", func(db *gorm.DB) *gorm.DB { return db.Unscoped() }).
+		Where("author = ? AND model_name = ?", provider, name).
+		First(&m).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if m.CreatedAt.After(at) {
+		return nil, ErrNotFound
+	}
+
+	pricing, err := r.pricingAt(ctx, m.ID, at)
+	if err != nil {
+		return nil, err
+	}
+	m.Pricing = pricing
+
+	for i := range m.Providers {
+		endpointPricing, err := r.endpointPricingAt(ctx, m.Providers[i].ID, at)
+		if err != nil {
+			return nil, err
+		}
+		m.Providers[i].Pricing = endpointPricing
+	}
+
+	return &m, nil
+}
+
+// ListEndpoints returns every non-deleted ModelEndpoint across all
+// models, ordered by provider so callers grouping by provider_name don't
+// need to re-sort.
+func (r *modelRepository) ListEndpoints(ctx context.Context) ([]models.ModelEndpoint, error) {
+	var endpoints []models.ModelEndpoint
+	if err := r.db.WithContext(ctx).Order("provider_name").Find(&endpoints).Error; err != nil {
+		return nil, err
+	}
+	return endpoints, nil
+}
+
+// UpdateEndpointHealth records a health probe's outcome directly, bypassing
+// Upsert's reconciliation machinery since a probe result isn't part of any
+// client-supplied payload.
+func (r *modelRepository) UpdateEndpointHealth(ctx context.Context, endpointID int64, status int, message string, checkedAt time.Time) error {
+	return r.db.WithContext(ctx).Model(&models.ModelEndpoint{}).
+		Where("id = ?", endpointID).
+		Updates(map[string]interface{}{
+			"status":          status,
+			"message":         message,
+			"last_checked_at": checkedAt,
+		}).Error
+}
+
+// UpdateEndpointHealthBatch applies each update via UpdateEndpointHealth
+// in turn, continuing past a single endpoint's failure so one bad ID in
+// the batch doesn't stop the rest from being recorded. Every failure is
+// preserved (identified by endpoint ID) rather than only the first, so a
+// caller logging the returned error can tell which endpoints didn't get
+// recorded.
+func (r *modelRepository) UpdateEndpointHealthBatch(ctx context.Context, updates []EndpointHealthUpdate) error {
+	var errs []error
+	for _, u := range updates {
+		if err := r.UpdateEndpointHealth(ctx, u.EndpointID, u.Status, u.Message, u.CheckedAt); err != nil {
+			errs = append(errs, fmt.Errorf("endpoint %d: %w", u.EndpointID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// pricingAt returns the most recent ModelPricingHistory row for modelID at
+// or before at, or nil if pricing hadn't been recorded yet.
+func (r *modelRepository) pricingAt(ctx context.Context, modelID int64, at time.Time) (*models.ModelPricing, error) {
+	var h models.ModelPricingHistory
+	err := r.db.WithContext(ctx).
+		Where("model_id = ? AND recorded_at <= ?", modelID, at).
+		Order("recorded_at DESC").
+		First(&h).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+	return &models.ModelPricing{
+		ModelID:               h.ModelID,
+		PromptCost:            h.PromptCost,
+		CompletionCost:        h.CompletionCost,
+		RequestCost:           h.RequestCost,
+		ImageCost:             h.ImageCost,
+		WebSearchCost:         h.WebSearchCost,
+		InternalReasoningCost: h.InternalReasoningCost,
+	}, nil
+}
+
+// endpointPricingAt is the per-endpoint analogue of pricingAt.
+func (r *modelRepository) endpointPricingAt(ctx context.Context, endpointID int64, at time.Time) (*models.ModelEndpointPricing, error) {
+	var h models.ModelEndpointPricingHistory
+	err := r.db.WithContext(ctx).
+		Where("endpoint_id = ? AND recorded_at <= ?", endpointID, at).
+		Order("recorded_at DESC").
+		First(&h).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, nil
+	case err != nil:
+		return nil, err
 	}
+	return &models.ModelEndpointPricing{
+		EndpointID:          h.EndpointID,
+		PromptCost:          h.PromptCost,
+		CompletionCost:      h.CompletionCost,
+		RequestCost:         h.RequestCost,
+		ImageCost:           h.ImageCost,
+		ImageOutputCost:     h.ImageOutputCost,
+		AudioCost:           h.AudioCost,
+		InputAudioCacheCost: h.InputAudioCacheCost,
+		InputCacheReadCost:  h.InputCacheReadCost,
+		InputCacheWriteCost: h.InputCacheWriteCost,
+		Discount:            h.Discount,
+	}, nil
 }
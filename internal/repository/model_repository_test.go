@@ -0,0 +1,339 @@
+package repository
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/adaptive/adaptive-model-registry/internal/database"
+	"github.com/adaptive/adaptive-model-registry/internal/models"
+)
+
+// newTestRepo opens a fresh, file-backed SQLite database (via the pure-Go
+// modernc driver, so these tests need no CGO) under t.TempDir(), migrates
+// every table ModelRepository touches, and returns a ModelRepository
+// backed by it. Each test gets its own database file, so tests can run in
+// parallel without sharing state.
+func newTestRepo(t *testing.T) ModelRepository {
+	t.Helper()
+
+	dsn := "sqlite+modernc://" + filepath.Join(t.TempDir(), "test.db")
+	db, err := database.OpenWithDriver(database.DriverSQLiteModernc, dsn)
+	if err != nil {
+		t.Fatalf("open test database: %v", err)
+	}
+
+	t.Cleanup(func() {
+		if err := database.Close(db); err != nil {
+			t.Logf("close test database: %v", err)
+		}
+	})
+
+	if err := db.AutoMigrate(
+		&models.Model{},
+		&models.ModelPricing{},
+		&models.ModelArchitecture{},
+		&models.ModelArchitectureModality{},
+		&models.ModelTopProvider{},
+		&models.ModelEndpoint{},
+		&models.ModelEndpointPricing{},
+		&models.ModelSupportedParameter{},
+		&models.ModelDefaultParameters{},
+		&models.ModelPricingHistory{},
+		&models.ModelEndpointPricingHistory{},
+	); err != nil {
+		t.Fatalf("auto-migrate test database: %v", err)
+	}
+
+	return NewModelRepository(db)
+}
+
+func ptr[T any](v T) *T { return &v }
+
+// fullModel builds a *models.Model with every relationship type populated,
+// for exercising Upsert's create path and the reconcileX helpers together.
+func fullModel() *models.Model {
+	return &models.Model{
+		Author:        "openai",
+		ModelName:     "gpt-4",
+		DisplayName:   "GPT-4",
+		ContextLength: 8192,
+		Pricing: &models.ModelPricing{
+			PromptCost:     "0.01",
+			CompletionCost: "0.03",
+		},
+		Architecture: &models.ModelArchitecture{
+			Modality:  "text->text",
+			Tokenizer: "cl100k_base",
+			Modalities: []models.ModelArchitectureModality{
+				{ModalityType: "input", ModalityValue: "text"},
+				{ModalityType: "output", ModalityValue: "text"},
+			},
+		},
+		TopProvider: &models.ModelTopProvider{
+			ContextLength: ptr(8192),
+		},
+		SupportedParameters: []models.ModelSupportedParameter{
+			{ParameterName: "temperature"},
+			{ParameterName: "top_p"},
+		},
+		DefaultParameters: &models.ModelDefaultParameters{
+			Parameters: models.DefaultParametersValues{
+				Temperature: ptr(0.7),
+			},
+		},
+		Providers: []models.ModelEndpoint{
+			{
+				ProviderName: "openai",
+				Tag:          "default",
+				Name:         "openai/gpt-4",
+				Pricing: &models.ModelEndpointPricing{
+					PromptCost:     "0.01",
+					CompletionCost: "0.03",
+				},
+			},
+		},
+	}
+}
+
+func TestUpsert_Create(t *testing.T) {
+	repo := newTestRepo(t)
+	ctx := context.Background()
+
+	if _, err := repo.Upsert(ctx, fullModel()); err != nil {
+		t.Fatalf("Upsert: %v", err)
+	}
+
+	got, err := repo.GetByProviderAndName(ctx, "openai", "gpt-4")
+	if err != nil {
+		t.Fatalf("GetByProviderAndName: %v", err)
+	}
+
+	if got.Pricing == nil || got.Pricing.PromptCost != "0.01" {
+		t.Errorf("Pricing = %+v, want prompt_cost 0.01", got.Pricing)
+	}
+	if got.Architecture == nil || len(got.Architecture.Modalities) != 2 {
+		t.Errorf("Architecture.Modalities = %+v, want 2 entries", got.Architecture)
+	}
+	if got.TopProvider == nil || got.TopProvider.ContextLength == nil || *got.TopProvider.ContextLength != 8192 {
+		t.Errorf("TopProvider = %+v, want context_length 8192", got.TopProvider)
+	}
+	if len(got.SupportedParameters) != 2 {
+		t.Errorf("SupportedParameters = %+v, want 2 entries", got.SupportedParameters)
+	}
+	if got.DefaultParameters == nil || got.DefaultParameters.Parameters.Temperature == nil {
+		t.Errorf("DefaultParameters = %+v, want temperature set", got.DefaultParameters)
+	}
+	if len(got.Providers) != 1 || got.Providers[0].Pricing == nil || got.Providers[0].Pricing.PromptCost != "0.01" {
+		t.Errorf("Providers = %+v, want 1 endpoint with prompt_cost 0.01", got.Providers)
+	}
+}
+
+// TestUpsert_ExistingEndpointPricing_NoCollision is a regression test for
+// the bug reconcileProviders had: Saving a matched existing ModelEndpoint
+// with its Pricing association still attached triggered GORM's
+// auto-save-associations behavior, which tried to create a second
+// ModelEndpointPricing row for the same endpoint_id and collided with its
+// uniqueIndex. Upserting the same model twice, each time with endpoint
+// pricing in the payload, is exactly the path that used to fail.
+func TestUpsert_ExistingEndpointPricing_NoCollision(t *testing.T) {
+	repo := newTestRepo(t)
+	ctx := context.Background()
+
+	if _, err := repo.Upsert(ctx, fullModel()); err != nil {
+		t.Fatalf("first Upsert: %v", err)
+	}
+
+	second := fullModel()
+	second.Providers[0].Pricing.PromptCost = "0.02"
+	second.Providers[0].Pricing.CompletionCost = "0.04"
+
+	if _, err := repo.Upsert(ctx, second); err != nil {
+		t.Fatalf("second Upsert (existing endpoint pricing): %v", err)
+	}
+
+	got, err := repo.GetByProviderAndName(ctx, "openai", "gpt-4")
+	if err != nil {
+		t.Fatalf("GetByProviderAndName: %v", err)
+	}
+	if len(got.Providers) != 1 {
+		t.Fatalf("Providers = %+v, want exactly 1 endpoint", got.Providers)
+	}
+	if got.Providers[0].Pricing == nil || got.Providers[0].Pricing.PromptCost != "0.02" {
+		t.Errorf("Providers[0].Pricing = %+v, want updated prompt_cost 0.02", got.Providers[0].Pricing)
+	}
+}
+
+// TestUpsert_Merge_KeepsOmittedRelationships asserts UpsertMerge leaves
+// child rows the incoming payload doesn't mention untouched, rather than
+// deleting them the way the default UpsertReplace mode would.
+func TestUpsert_Merge_KeepsOmittedRelationships(t *testing.T) {
+	repo := newTestRepo(t)
+	ctx := context.Background()
+
+	if _, err := repo.Upsert(ctx, fullModel()); err != nil {
+		t.Fatalf("first Upsert: %v", err)
+	}
+
+	second := fullModel()
+	second.Pricing = nil
+	second.Architecture = nil
+	second.TopProvider = nil
+	second.SupportedParameters = nil
+	second.DefaultParameters = nil
+	second.Providers = nil
+
+	if _, err := repo.Upsert(ctx, second, UpsertOptions{Mode: UpsertMerge}); err != nil {
+		t.Fatalf("second Upsert (merge): %v", err)
+	}
+
+	got, err := repo.GetByProviderAndName(ctx, "openai", "gpt-4")
+	if err != nil {
+		t.Fatalf("GetByProviderAndName: %v", err)
+	}
+	if got.Pricing == nil {
+		t.Error("Pricing = nil, want the original pricing row left untouched under UpsertMerge")
+	}
+	if got.Architecture == nil || len(got.Architecture.Modalities) != 2 {
+		t.Errorf("Architecture = %+v, want the original architecture/modalities left untouched", got.Architecture)
+	}
+	if got.TopProvider == nil {
+		t.Error("TopProvider = nil, want the original top-provider row left untouched under UpsertMerge")
+	}
+	if len(got.SupportedParameters) != 2 {
+		t.Errorf("SupportedParameters = %+v, want the original 2 entries left untouched", got.SupportedParameters)
+	}
+	if got.DefaultParameters == nil {
+		t.Error("DefaultParameters = nil, want the original default-parameters row left untouched under UpsertMerge")
+	}
+	if len(got.Providers) != 1 {
+		t.Errorf("Providers = %+v, want the original endpoint left untouched", got.Providers)
+	}
+}
+
+// TestUpsert_Replace_DropsOmittedRelationships asserts the default
+// UpsertReplace mode removes child rows the incoming payload no longer
+// mentions, so the payload fully describes the model afterward.
+func TestUpsert_Replace_DropsOmittedRelationships(t *testing.T) {
+	repo := newTestRepo(t)
+	ctx := context.Background()
+
+	if _, err := repo.Upsert(ctx, fullModel()); err != nil {
+		t.Fatalf("first Upsert: %v", err)
+	}
+
+	second := fullModel()
+	second.Pricing = nil
+	second.SupportedParameters = nil
+	second.Providers = nil
+
+	if _, err := repo.Upsert(ctx, second); err != nil {
+		t.Fatalf("second Upsert (replace): %v", err)
+	}
+
+	got, err := repo.GetByProviderAndName(ctx, "openai", "gpt-4")
+	if err != nil {
+		t.Fatalf("GetByProviderAndName: %v", err)
+	}
+	if got.Pricing != nil {
+		t.Errorf("Pricing = %+v, want nil after UpsertReplace dropped it", got.Pricing)
+	}
+	if len(got.SupportedParameters) != 0 {
+		t.Errorf("SupportedParameters = %+v, want empty after UpsertReplace dropped them", got.SupportedParameters)
+	}
+	if len(got.Providers) != 0 {
+		t.Errorf("Providers = %+v, want empty after UpsertReplace dropped the endpoint", got.Providers)
+	}
+}
+
+// TestUpsert_PreserveEndpointHealth asserts that opt.PreserveEndpointHealth
+// leaves a matched endpoint's Status/Message/LastCheckedAt exactly as
+// stored, instead of overwriting them with the incoming payload's
+// (zero-valued) fields - the behavior catalog-driven callers rely on to
+// keep a resync from clobbering the health Scheduler's latest probe.
+func TestUpsert_PreserveEndpointHealth(t *testing.T) {
+	repo := newTestRepo(t)
+	ctx := context.Background()
+
+	first := fullModel()
+	first.Providers[0].Status = 1
+	first.Providers[0].Message = "connection refused"
+	checkedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	first.Providers[0].LastCheckedAt = &checkedAt
+
+	if _, err := repo.Upsert(ctx, first); err != nil {
+		t.Fatalf("first Upsert: %v", err)
+	}
+
+	second := fullModel()
+	if _, err := repo.Upsert(ctx, second, UpsertOptions{PreserveEndpointHealth: true}); err != nil {
+		t.Fatalf("second Upsert (preserve health): %v", err)
+	}
+
+	got, err := repo.GetByProviderAndName(ctx, "openai", "gpt-4")
+	if err != nil {
+		t.Fatalf("GetByProviderAndName: %v", err)
+	}
+	if len(got.Providers) != 1 {
+		t.Fatalf("Providers = %+v, want exactly 1 endpoint", got.Providers)
+	}
+	ep := got.Providers[0]
+	if ep.Status != 1 {
+		t.Errorf("Status = %d, want 1 (the Scheduler's probe result) left untouched", ep.Status)
+	}
+	if ep.Message != "connection refused" {
+		t.Errorf("Message = %q, want the original probe message left untouched", ep.Message)
+	}
+	if ep.LastCheckedAt == nil || !ep.LastCheckedAt.Equal(checkedAt) {
+		t.Errorf("LastCheckedAt = %+v, want %v left untouched", ep.LastCheckedAt, checkedAt)
+	}
+}
+
+// TestUpsert_Replace_AddsAndMutatesRelationships exercises the remaining
+// per-relationship-type paths a plain add/drop pair doesn't: adding a
+// second endpoint on a second Upsert, and mutating an already-stored
+// ModelArchitecture/ModelTopProvider/ModelDefaultParameters in place.
+func TestUpsert_Replace_AddsAndMutatesRelationships(t *testing.T) {
+	repo := newTestRepo(t)
+	ctx := context.Background()
+
+	if _, err := repo.Upsert(ctx, fullModel()); err != nil {
+		t.Fatalf("first Upsert: %v", err)
+	}
+
+	second := fullModel()
+	second.Architecture.Tokenizer = "o200k_base"
+	second.TopProvider.ContextLength = ptr(16384)
+	second.DefaultParameters.Parameters.Temperature = ptr(0.2)
+	second.Providers = append(second.Providers, models.ModelEndpoint{
+		ProviderName: "openai",
+		Tag:          "azure",
+		Name:         "openai/gpt-4-azure",
+		Pricing: &models.ModelEndpointPricing{
+			PromptCost:     "0.015",
+			CompletionCost: "0.045",
+		},
+	})
+
+	if _, err := repo.Upsert(ctx, second); err != nil {
+		t.Fatalf("second Upsert (add + mutate): %v", err)
+	}
+
+	got, err := repo.GetByProviderAndName(ctx, "openai", "gpt-4")
+	if err != nil {
+		t.Fatalf("GetByProviderAndName: %v", err)
+	}
+	if got.Architecture == nil || got.Architecture.Tokenizer != "o200k_base" {
+		t.Errorf("Architecture.Tokenizer = %+v, want o200k_base", got.Architecture)
+	}
+	if got.TopProvider == nil || got.TopProvider.ContextLength == nil || *got.TopProvider.ContextLength != 16384 {
+		t.Errorf("TopProvider.ContextLength = %+v, want 16384", got.TopProvider)
+	}
+	if got.DefaultParameters == nil || got.DefaultParameters.Parameters.Temperature == nil || *got.DefaultParameters.Parameters.Temperature != 0.2 {
+		t.Errorf("DefaultParameters.Parameters.Temperature = %+v, want 0.2", got.DefaultParameters)
+	}
+	if len(got.Providers) != 2 {
+		t.Fatalf("Providers = %+v, want 2 endpoints after adding one", got.Providers)
+	}
+}
@@ -2,6 +2,11 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/adaptive/adaptive-model-registry/internal/models"
 	"gorm.io/gorm"
@@ -10,30 +15,140 @@ import (
 // ProviderRepository defines persistence operations for provider metadata.
 type ProviderRepository interface {
 	List(ctx context.Context, filter models.ProviderFilter) ([]models.Provider, error)
+	// ListPage returns a single page of List's result, ordered by name and
+	// bounded by limit. When cursor is non-empty, only providers sorting
+	// after it are returned.
+	ListPage(ctx context.Context, filter models.ProviderFilter, cursor string, limit int) ([]models.Provider, error)
+}
+
+// EncodeProviderCursor serializes the last provider name of a page into the
+// opaque cursor handed back to clients.
+func EncodeProviderCursor(name string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(name))
+}
+
+// DecodeProviderCursor parses a cursor produced by EncodeProviderCursor.
+func DecodeProviderCursor(cursor string) (string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", fmt.Errorf("decode cursor: %w", err)
+	}
+	return string(raw), nil
 }
 
 type providerRepository struct {
 	db *gorm.DB
 }
 
-// NewProviderRepository constructs a ProviderRepository backed by Postgres via GORM.
+// NewProviderRepository constructs a ProviderRepository backed by db. List's
+// aggregate query adapts to db's dialect (see aggregateSelect); Postgres,
+// SQLite, and MySQL are all supported.
 func NewProviderRepository(db *gorm.DB) ProviderRepository {
 	return &providerRepository{db: db}
 }
 
 func (r *providerRepository) List(ctx context.Context, filter models.ProviderFilter) ([]models.Provider, error) {
-	var providers []models.Provider
+	return r.scanProviders(r.filteredQuery(ctx, filter))
+}
+
+// ListPage is List with keyset pagination on the deterministic
+// provider_name order the aggregate query already groups by: providers
+// sorting at or before cursor are skipped via a HAVING clause on the
+// grouped column, and the result is capped at limit.
+func (r *providerRepository) ListPage(ctx context.Context, filter models.ProviderFilter, cursor string, limit int) ([]models.Provider, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+
+	query := r.filteredQuery(ctx, filter)
+	if cursor != "" {
+		query = query.Having("provider_name > ?", cursor)
+	}
+	return r.scanProviders(query.Limit(limit))
+}
+
+// scanProviders runs query and scans its aggregate columns into
+// []models.Provider, using the Scan target aggregateSelect's SELECT clause
+// actually produced for r.db's dialect (see providerAggRow).
+func (r *providerRepository) scanProviders(query *gorm.DB) ([]models.Provider, error) {
+	if r.db.Dialector.Name() == "postgres" {
+		var providers []models.Provider
+		if err := query.Scan(&providers).Error; err != nil {
+			return nil, err
+		}
+		return providers, nil
+	}
+
+	var rows []providerAggRow
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	return providerRowsToModels(rows), nil
+}
+
+// providerAggRow is the non-Postgres scan target for filteredQuery's
+// aggregate columns: SQLite and MySQL have no array type to scan Tags/
+// Quantizations into directly, so they're aggregated as a comma-joined
+// string instead and split back into []string by providerRowsToModels.
+type providerAggRow struct {
+	Name             string         `gorm:"column:name"`
+	TagsCSV          sql.NullString `gorm:"column:tags"`
+	ModelCount       int            `gorm:"column:model_count"`
+	EndpointCount    int            `gorm:"column:endpoint_count"`
+	ActiveCount      int            `gorm:"column:active_count"`
+	QuantizationsCSV sql.NullString `gorm:"column:quantizations"`
+}
+
+// providerRowsToModels converts providerAggRow's comma-joined columns back
+// into models.Provider's []string fields. It returns nil for an empty rows,
+// matching Scan's own zero-matches result on the Postgres path, so List's
+// result shape for "nothing matched" doesn't depend on which dialect is
+// configured.
+func providerRowsToModels(rows []providerAggRow) []models.Provider {
+	if len(rows) == 0 {
+		return nil
+	}
+	providers := make([]models.Provider, len(rows))
+	for i, row := range rows {
+		providers[i] = models.Provider{
+			Name:          row.Name,
+			Tags:          splitCSV(row.TagsCSV),
+			ModelCount:    row.ModelCount,
+			EndpointCount: row.EndpointCount,
+			ActiveCount:   row.ActiveCount,
+			Quantizations: splitCSV(row.QuantizationsCSV),
+		}
+	}
+	return providers
+}
+
+// splitCSV splits a GROUP_CONCAT-style comma-joined column into its parts,
+// returning nil when the aggregate found no matching rows (GROUP_CONCAT(DISTINCT
+// CASE ...) is SQL NULL in that case, not "") rather than []string{""}.
+// SQLite's GROUP_CONCAT(DISTINCT ...) has no custom-separator form (it
+// errors if given one), so this assumes ',' never appears inside a tag or
+// quantization value itself; a value containing a literal comma would be
+// split into extra entries on SQLite/MySQL while staying intact under
+// Postgres's ARRAY_AGG. Tags/quantizations are operator-supplied short
+// identifiers in practice, so this is an accepted limitation of the
+// non-Postgres fallback rather than something worth a more elaborate
+// encoding.
+func splitCSV(s sql.NullString) []string {
+	if !s.Valid || s.String == "" {
+		return nil
+	}
+	return strings.Split(s.String, ",")
+}
 
-	// Base query to get unique provider names with aggregated data
+// filteredQuery builds the grouped/filtered query shared by List and
+// ListPage. The aggregate SELECT is Postgres-specific (ARRAY_AGG...FILTER)
+// versus a portable GROUP_CONCAT/SUM-CASE form for SQLite and MySQL, both
+// of which default to a comma separator for GROUP_CONCAT(DISTINCT ...);
+// every other clause (Group, Order, the filter subqueries below) is plain
+// SQL and applies unchanged across dialects.
+func (r *providerRepository) filteredQuery(ctx context.Context, filter models.ProviderFilter) *gorm.DB {
 	query := r.db.WithContext(ctx).Table("model_endpoints").
-		Select(`
-			provider_name as name,
-			ARRAY_AGG(DISTINCT tag) FILTER (WHERE tag IS NOT NULL AND tag != '') as tags,
-			COUNT(DISTINCT model_id) as model_count,
-			COUNT(*) as endpoint_count,
-			COUNT(*) FILTER (WHERE status = 0) as active_count,
-			ARRAY_AGG(DISTINCT quantization) FILTER (WHERE quantization IS NOT NULL AND quantization != '') as quantizations
-		`).
+		Select(r.aggregateSelect()).
 		Group("provider_name").
 		Order("provider_name")
 
@@ -107,10 +222,78 @@ func (r *providerRepository) List(ctx context.Context, filter models.ProviderFil
 		query = query.Where("provider_name IN (?)", subQuery)
 	}
 
-	// Execute the query
-	if err := query.Scan(&providers).Error; err != nil {
-		return nil, err
+	if filter.MaxPromptCost != nil {
+		subQuery := r.db.WithContext(ctx).Table("model_endpoints").
+			Select("DISTINCT model_endpoints.provider_name").
+			Joins("JOIN model_endpoint_pricing ON model_endpoint_pricing.endpoint_id = model_endpoints.id").
+			Where("model_endpoint_pricing.prompt_cost <= ?", *filter.MaxPromptCost)
+		query = query.Where("provider_name IN (?)", subQuery)
+	}
+
+	if filter.MaxCompletionCost != nil {
+		subQuery := r.db.WithContext(ctx).Table("model_endpoints").
+			Select("DISTINCT model_endpoints.provider_name").
+			Joins("JOIN model_endpoint_pricing ON model_endpoint_pricing.endpoint_id = model_endpoints.id").
+			Where("model_endpoint_pricing.completion_cost <= ?", *filter.MaxCompletionCost)
+		query = query.Where("provider_name IN (?)", subQuery)
+	}
+
+	if len(filter.SupportedParams) > 0 {
+		// AND between entries: a provider must serve at least one model
+		// declaring each listed parameter, mirroring modelRepository's
+		// per-param subquery loop.
+		for _, param := range filter.SupportedParams {
+			subQuery := r.db.WithContext(ctx).Table("model_endpoints").
+				Select("DISTINCT model_endpoints.provider_name").
+				Joins("JOIN llm_models ON llm_models.id = model_endpoints.model_id").
+				Joins("JOIN model_supported_parameters ON model_supported_parameters.model_id = llm_models.id").
+				Where("model_supported_parameters.parameter_name = ?", param)
+			query = query.Where("provider_name IN (?)", subQuery)
+		}
+	}
+
+	if filter.HealthyWithinMinutes != nil {
+		// Filter providers that have at least one endpoint the health
+		// Scheduler observed healthy within the last N minutes
+		cutoff := time.Now().Add(-time.Duration(*filter.HealthyWithinMinutes) * time.Minute)
+		subQuery := r.db.WithContext(ctx).Table("model_endpoints").
+			Select("DISTINCT provider_name").
+			Where("status = 0").
+			Where("last_checked_at >= ?", cutoff)
+		query = query.Where("provider_name IN (?)", subQuery)
+	}
+
+	return query
+}
+
+// aggregateSelect returns filteredQuery's SELECT clause for r.db's dialect:
+// Postgres keeps its native ARRAY_AGG...FILTER form (scanned straight into
+// models.Provider's []string fields by the postgres driver), while every
+// other dialect gets a GROUP_CONCAT/SUM-CASE equivalent that scans into
+// providerAggRow's comma-joined string columns instead. On MySQL this is
+// subject to the server's group_concat_max_len (1024 bytes by default),
+// which this package doesn't override, so a provider whose combined
+// tags/quantizations exceed it gets silently truncated the way plain
+// GROUP_CONCAT always does - unlike Postgres's ARRAY_AGG, which has no
+// such limit.
+func (r *providerRepository) aggregateSelect() string {
+	if r.db.Dialector.Name() == "postgres" {
+		return `
+			provider_name as name,
+			ARRAY_AGG(DISTINCT tag) FILTER (WHERE tag IS NOT NULL AND tag != '') as tags,
+			COUNT(DISTINCT model_id) as model_count,
+			COUNT(*) as endpoint_count,
+			COUNT(*) FILTER (WHERE status = 0) as active_count,
+			ARRAY_AGG(DISTINCT quantization) FILTER (WHERE quantization IS NOT NULL AND quantization != '') as quantizations
+		`
 	}
 
-	return providers, nil
+	return `
+		provider_name as name,
+		GROUP_CONCAT(DISTINCT CASE WHEN tag IS NOT NULL AND tag != '' THEN tag END) as tags,
+		COUNT(DISTINCT model_id) as model_count,
+		COUNT(*) as endpoint_count,
+		SUM(CASE WHEN status = 0 THEN 1 ELSE 0 END) as active_count,
+		GROUP_CONCAT(DISTINCT CASE WHEN quantization IS NOT NULL AND quantization != '' THEN quantization END) as quantizations
+	`
 }
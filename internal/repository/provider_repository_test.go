@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/adaptive/adaptive-model-registry/internal/models"
+)
+
+// newTestProviderRepo reuses newTestRepo's SQLite setup, returning a
+// ProviderRepository over the same database so ProviderRepository.List
+// exercises the non-Postgres aggregateSelect path this test targets.
+func newTestProviderRepo(t *testing.T) (ModelRepository, ProviderRepository) {
+	t.Helper()
+	models := newTestRepo(t)
+	r := models.(*modelRepository)
+	return models, NewProviderRepository(r.db)
+}
+
+// TestProviderRepository_List_SQLite is a regression test for List's
+// aggregate query being Postgres-only (ARRAY_AGG...FILTER): it exercises
+// the GROUP_CONCAT/SUM-CASE path aggregateSelect falls back to on every
+// other dialect, via the same SQLite driver the rest of the suite uses.
+func TestProviderRepository_List_SQLite(t *testing.T) {
+	modelRepo, providerRepo := newTestProviderRepo(t)
+	ctx := context.Background()
+
+	gpt4 := fullModel()
+	gpt4.Providers = []models.ModelEndpoint{
+		{ProviderName: "openai", Tag: "default", Name: "openai/gpt-4", Status: 0},
+		{ProviderName: "openai", Tag: "azure", Name: "openai/gpt-4-azure", Status: 1, Quantization: "fp16"},
+	}
+	if _, err := modelRepo.Upsert(ctx, gpt4); err != nil {
+		t.Fatalf("Upsert gpt4: %v", err)
+	}
+
+	claude := fullModel()
+	claude.ModelName = "claude-3"
+	claude.Providers = []models.ModelEndpoint{
+		{ProviderName: "anthropic", Tag: "default", Name: "anthropic/claude-3", Status: 0, Quantization: "fp16"},
+	}
+	if _, err := modelRepo.Upsert(ctx, claude); err != nil {
+		t.Fatalf("Upsert claude: %v", err)
+	}
+
+	// mistral has no tag or quantization on its only endpoint, so its
+	// aggregated tags/quantizations columns come back NULL/empty - the
+	// path splitCSV's nil-on-empty-string branch covers.
+	mistral := fullModel()
+	mistral.ModelName = "mistral-large"
+	mistral.Providers = []models.ModelEndpoint{
+		{ProviderName: "mistral", Name: "mistral/mistral-large", Status: 0},
+	}
+	if _, err := modelRepo.Upsert(ctx, mistral); err != nil {
+		t.Fatalf("Upsert mistral: %v", err)
+	}
+
+	providers, err := providerRepo.List(ctx, models.ProviderFilter{})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(providers) != 3 {
+		t.Fatalf("providers = %+v, want 3 entries", providers)
+	}
+
+	// Order("provider_name") sorts anthropic, mistral, openai.
+	anthropic, mistral2, openai := providers[0], providers[1], providers[2]
+
+	if anthropic.Name != "anthropic" || anthropic.EndpointCount != 1 || anthropic.ActiveCount != 1 {
+		t.Errorf("anthropic = %+v, want name=anthropic endpoint_count=1 active_count=1", anthropic)
+	}
+	if len(anthropic.Quantizations) != 1 || anthropic.Quantizations[0] != "fp16" {
+		t.Errorf("anthropic.Quantizations = %+v, want [fp16]", anthropic.Quantizations)
+	}
+
+	if mistral2.Name != "mistral" || len(mistral2.Tags) != 0 || len(mistral2.Quantizations) != 0 {
+		t.Errorf("mistral = %+v, want no tags and no quantizations", mistral2)
+	}
+
+	if openai.Name != "openai" || openai.EndpointCount != 2 || openai.ActiveCount != 1 {
+		t.Errorf("openai = %+v, want name=openai endpoint_count=2 active_count=1", openai)
+	}
+	if len(openai.Tags) != 2 {
+		t.Errorf("openai.Tags = %+v, want 2 entries (default, azure)", openai.Tags)
+	}
+}
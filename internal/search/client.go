@@ -0,0 +1,150 @@
+package search
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/elastic/go-elasticsearch/v8/esapi"
+)
+
+// indexMapping declares the fields Document needs term/range/full-text
+// behavior on. Fields not listed here fall back to Elasticsearch's
+// dynamic mapping, which is fine for the purely-informational ones.
+const indexMapping = `{
+  "mappings": {
+    "properties": {
+      "author":               { "type": "text" },
+      "model_name":           { "type": "text" },
+      "display_name":         { "type": "text" },
+      "description":          { "type": "text" },
+      "provider_name":        { "type": "keyword" },
+      "tag":                  { "type": "keyword" },
+      "quantization":         { "type": "keyword" },
+      "context_length":       { "type": "integer" },
+      "status":                { "type": "integer" },
+      "tokenizer":            { "type": "keyword" },
+      "instruct_type":        { "type": "keyword" },
+      "input_modalities":     { "type": "keyword" },
+      "output_modalities":    { "type": "keyword" },
+      "supported_parameters": { "type": "keyword" },
+      "prompt_cost_value":    { "type": "double" },
+      "tags":                 { "type": "keyword" }
+    }
+  }
+}`
+
+// Client wraps the Elasticsearch transport with the handful of
+// operations this package needs: index bootstrap, bulk writes, and
+// search. It's a thin wrapper rather than a full repository so Indexer
+// and the search-side repository.go can share the connection without
+// depending on each other.
+type Client struct {
+	es    *elasticsearch.Client
+	index string
+}
+
+// NewClient dials the cluster at addresses and targets index for every
+// subsequent operation.
+func NewClient(addresses []string, index string) (*Client, error) {
+	es, err := elasticsearch.NewClient(elasticsearch.Config{Addresses: addresses})
+	if err != nil {
+		return nil, fmt.Errorf("new elasticsearch client: %w", err)
+	}
+	return &Client{es: es, index: index}, nil
+}
+
+// EnsureIndex creates the index with indexMapping if it doesn't already
+// exist. Safe to call on every startup.
+func (c *Client) EnsureIndex(ctx context.Context) error {
+	exists, err := esapi.IndicesExistsRequest{Index: []string{c.index}}.Do(ctx, c.es)
+	if err != nil {
+		return fmt.Errorf("check index: %w", err)
+	}
+	defer exists.Body.Close()
+	if exists.StatusCode == 200 {
+		return nil
+	}
+
+	create, err := esapi.IndicesCreateRequest{
+		Index: c.index,
+		Body:  bytes.NewReader([]byte(indexMapping)),
+	}.Do(ctx, c.es)
+	if err != nil {
+		return fmt.Errorf("create index: %w", err)
+	}
+	defer create.Body.Close()
+	if create.IsError() {
+		return fmt.Errorf("create index: %s", bodyString(create))
+	}
+	return nil
+}
+
+// Bulk submits a pre-built NDJSON bulk request body (see Indexer.flush)
+// and reports whether any individual item failed.
+func (c *Client) Bulk(ctx context.Context, body []byte) error {
+	res, err := esapi.BulkRequest{
+		Index: c.index,
+		Body:  bytes.NewReader(body),
+	}.Do(ctx, c.es)
+	if err != nil {
+		return fmt.Errorf("bulk request: %w", err)
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return fmt.Errorf("bulk request: %s", bodyString(res))
+	}
+
+	var decoded struct {
+		Errors bool `json:"errors"`
+		Items  []map[string]struct {
+			Status int `json:"status"`
+			Error  *struct {
+				Reason string `json:"reason"`
+			} `json:"error,omitempty"`
+		} `json:"items"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&decoded); err != nil {
+		return fmt.Errorf("decode bulk response: %w", err)
+	}
+	if !decoded.Errors {
+		return nil
+	}
+
+	for _, item := range decoded.Items {
+		for action, result := range item {
+			if result.Error != nil {
+				return fmt.Errorf("bulk %s: %s", action, result.Error.Reason)
+			}
+		}
+	}
+	return fmt.Errorf("bulk request reported errors without detail")
+}
+
+// Search runs a raw query-DSL body against the index and decodes the
+// response into result.
+func (c *Client) Search(ctx context.Context, body []byte, result interface{}) error {
+	res, err := esapi.SearchRequest{
+		Index: []string{c.index},
+		Body:  bytes.NewReader(body),
+	}.Do(ctx, c.es)
+	if err != nil {
+		return fmt.Errorf("search: %w", err)
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return fmt.Errorf("search: %s", bodyString(res))
+	}
+	if err := json.NewDecoder(res.Body).Decode(result); err != nil {
+		return fmt.Errorf("decode search response: %w", err)
+	}
+	return nil
+}
+
+func bodyString(res *esapi.Response) string {
+	b, _ := io.ReadAll(res.Body)
+	return string(b)
+}
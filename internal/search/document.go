@@ -0,0 +1,112 @@
+// Package search indexes model endpoints into Elasticsearch/OpenSearch
+// alongside the Postgres store, so free-text queries and multi-dimension
+// facet aggregations don't have to be expressed as the per-filter
+// sub-SELECTs ProviderRepository/ModelRepository use. Postgres remains
+// the source of truth; this package only ever mirrors writes into the
+// index and reads back what's already there.
+package search
+
+import "github.com/adaptive/adaptive-model-registry/internal/models"
+
+// Document is the flattened, denormalized unit indexed per model
+// endpoint: one Model can produce several Documents, one per entry in
+// its Providers slice, so a query can filter/facet on endpoint-level
+// fields (provider, quantization, per-endpoint pricing) without a join.
+type Document struct {
+	// ID is the document's Elasticsearch _id, so re-indexing the same
+	// endpoint overwrites rather than duplicates it.
+	ID         string `json:"id"`
+	EndpointID int64  `json:"endpoint_id"`
+	ModelID    int64  `json:"model_id"`
+
+	Author      string `json:"author"`
+	ModelName   string `json:"model_name"`
+	DisplayName string `json:"display_name,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	ProviderName  string `json:"provider_name"`
+	Tag           string `json:"tag,omitempty"`
+	Quantization  string `json:"quantization,omitempty"`
+	ContextLength int    `json:"context_length"`
+	Status        int    `json:"status"`
+
+	Tokenizer        string   `json:"tokenizer,omitempty"`
+	InstructType     string   `json:"instruct_type,omitempty"`
+	InputModalities  []string `json:"input_modalities,omitempty"`
+	OutputModalities []string `json:"output_modalities,omitempty"`
+
+	SupportedParameters []string `json:"supported_parameters,omitempty"`
+
+	// PromptCost/CompletionCost keep the original decimal-as-string
+	// representation for display; the *Value fields are the same costs
+	// parsed to float64 so range aggregations have something numeric to
+	// bucket on.
+	PromptCost      string  `json:"prompt_cost,omitempty"`
+	PromptCostValue float64 `json:"prompt_cost_value,omitempty"`
+	CompletionCost  string  `json:"completion_cost,omitempty"`
+
+	Tags []string `json:"tags,omitempty"`
+}
+
+// DocumentsForModel builds one Document per endpoint in m.Providers. A
+// model with no endpoints yet produces no documents, since there's
+// nothing endpoint-scoped to search for.
+func DocumentsForModel(m models.Model) []Document {
+	docs := make([]Document, 0, len(m.Providers))
+	for _, e := range m.Providers {
+		doc := Document{
+			ID:            endpointDocID(e.ID),
+			EndpointID:    e.ID,
+			ModelID:       m.ID,
+			Author:        m.Author,
+			ModelName:     m.ModelName,
+			DisplayName:   m.DisplayName,
+			Description:   m.Description,
+			ProviderName:  e.ProviderName,
+			Tag:           e.Tag,
+			Quantization:  e.Quantization,
+			ContextLength: e.ContextLength,
+			Status:        e.Status,
+		}
+
+		if m.Architecture != nil {
+			doc.Tokenizer = m.Architecture.Tokenizer
+			doc.InstructType = m.Architecture.InstructType
+			for _, mod := range m.Architecture.Modalities {
+				switch mod.ModalityType {
+				case "input":
+					doc.InputModalities = append(doc.InputModalities, mod.ModalityValue)
+				case "output":
+					doc.OutputModalities = append(doc.OutputModalities, mod.ModalityValue)
+				}
+			}
+		}
+
+		for _, sp := range m.SupportedParameters {
+			doc.SupportedParameters = append(doc.SupportedParameters, string(sp.ParameterName))
+		}
+
+		if e.Pricing != nil {
+			doc.PromptCost = e.Pricing.PromptCost
+			doc.PromptCostValue = parseCost(e.Pricing.PromptCost)
+			doc.CompletionCost = e.Pricing.CompletionCost
+		} else if m.Pricing != nil {
+			doc.PromptCost = m.Pricing.PromptCost
+			doc.PromptCostValue = parseCost(m.Pricing.PromptCost)
+			doc.CompletionCost = m.Pricing.CompletionCost
+		}
+
+		if e.Tag != "" {
+			doc.Tags = []string{e.Tag}
+		}
+
+		docs = append(docs, doc)
+	}
+	return docs
+}
+
+// endpointDocID derives a Document's Elasticsearch _id from its
+// underlying endpoint ID.
+func endpointDocID(endpointID int64) string {
+	return "endpoint-" + itoa(endpointID)
+}
@@ -0,0 +1,183 @@
+package search
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+)
+
+// Indexer batches Document writes and deletes behind a bulk processor,
+// so a burst of repository writes (e.g. BulkImport) doesn't turn into
+// one Elasticsearch request per document. It mirrors the shape of
+// services.SyncWorker/health.Scheduler: an immediate-then-ticker Run
+// loop driven by a context, plus an explicit Flush for callers (tests,
+// graceful shutdown) that can't wait for the next tick.
+type Indexer struct {
+	client         *Client
+	batchSize      int
+	flushInterval  time.Duration
+	maxRetries     int
+	initialBackoff time.Duration
+
+	mu      sync.Mutex
+	pending []bulkOp
+}
+
+type bulkOp struct {
+	action   string // "index" or "delete"
+	id       string
+	document *Document
+}
+
+// NewIndexer constructs an Indexer that flushes once batchSize pending
+// operations have queued up, or every flushInterval, whichever comes
+// first. A flushInterval <= 0 disables the periodic flush, leaving the
+// batchSize check on every Index/Delete call and an explicit Flush as
+// the only triggers.
+func NewIndexer(client *Client, batchSize int, flushInterval time.Duration) *Indexer {
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+	return &Indexer{
+		client:         client,
+		batchSize:      batchSize,
+		flushInterval:  flushInterval,
+		maxRetries:     5,
+		initialBackoff: 500 * time.Millisecond,
+	}
+}
+
+// Run flushes on startup and then every i.flushInterval, until ctx is
+// canceled. An interval <= 0 disables the periodic flush; Run returns
+// after the initial flush.
+func (i *Indexer) Run(ctx context.Context) {
+	if err := i.Flush(ctx); err != nil {
+		log.Printf("search: initial flush failed: %v", err)
+	}
+
+	if i.flushInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(i.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := i.Flush(ctx); err != nil {
+				log.Printf("search: flush failed: %v", err)
+			}
+		}
+	}
+}
+
+// Index queues doc for indexing, flushing immediately if the batch is
+// now full.
+func (i *Indexer) Index(ctx context.Context, doc Document) error {
+	return i.enqueue(ctx, bulkOp{action: "index", id: doc.ID, document: &doc})
+}
+
+// Delete queues the document with the given id for removal, flushing
+// immediately if the batch is now full.
+func (i *Indexer) Delete(ctx context.Context, id string) error {
+	return i.enqueue(ctx, bulkOp{action: "delete", id: id})
+}
+
+func (i *Indexer) enqueue(ctx context.Context, op bulkOp) error {
+	i.mu.Lock()
+	i.pending = append(i.pending, op)
+	full := len(i.pending) >= i.batchSize
+	i.mu.Unlock()
+
+	if !full {
+		return nil
+	}
+	return i.Flush(ctx)
+}
+
+// Flush sends every currently-queued operation as a single bulk
+// request, retrying with exponential backoff on failure. Operations
+// queued while a Flush is already running wait for the next call
+// rather than being picked up mid-flight. If every retry is exhausted,
+// the batch is put back at the front of the queue instead of being
+// dropped, so the next Flush (the next tick, or the next Index/Delete
+// that fills a batch) tries again rather than silently losing writes.
+func (i *Indexer) Flush(ctx context.Context) error {
+	i.mu.Lock()
+	batch := i.pending
+	i.pending = nil
+	i.mu.Unlock()
+
+	if len(batch) == 0 {
+		return nil
+	}
+
+	body, err := encodeBulkBody(batch)
+	if err != nil {
+		return err
+	}
+
+	backoff := i.initialBackoff
+	var lastErr error
+	for attempt := 0; attempt <= i.maxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				lastErr = ctx.Err()
+				i.requeue(batch)
+				return lastErr
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+
+		if lastErr = i.client.Bulk(ctx, body); lastErr == nil {
+			return nil
+		}
+	}
+
+	i.requeue(batch)
+	return lastErr
+}
+
+// requeue puts a failed batch back at the front of i.pending, ahead of
+// anything queued since, so it's retried by the next Flush rather than
+// discarded.
+func (i *Indexer) requeue(batch []bulkOp) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.pending = append(batch, i.pending...)
+}
+
+// encodeBulkBody renders batch as the newline-delimited action/document
+// pairs the Elasticsearch _bulk API expects.
+func encodeBulkBody(batch []bulkOp) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, op := range batch {
+		switch op.action {
+		case "delete":
+			if err := enc.Encode(map[string]interface{}{
+				"delete": map[string]string{"_id": op.id},
+			}); err != nil {
+				return nil, err
+			}
+		default:
+			if err := enc.Encode(map[string]interface{}{
+				"index": map[string]string{"_id": op.id},
+			}); err != nil {
+				return nil, err
+			}
+			if err := enc.Encode(op.document); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,210 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// SearchFilter narrows a free-text query to a subset of documents. Every
+// field is optional; empty/zero fields are left out of the built query,
+// mirroring how ModelFilter/ProviderFilter's optional fields work.
+type SearchFilter struct {
+	Providers        []string
+	Quantizations    []string
+	InputModalities  []string
+	OutputModalities []string
+	MinContextLength *int
+	MaxPromptCost    *float64
+}
+
+// SearchResult is a page of matching documents plus, when requested,
+// per-field facet counts.
+type SearchResult struct {
+	Hits   []Document         `json:"hits"`
+	Total  int64              `json:"total"`
+	Facets map[string][]Facet `json:"facets,omitempty"`
+}
+
+// Facet is one bucket of a terms aggregation: a distinct value for the
+// faceted field, and how many matching documents carry it.
+type Facet struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+// facetableFields maps the facet names a caller may request to the
+// keyword field each aggregates on. Only keyword-typed fields are safe
+// to bucket on a terms aggregation without blowing up cardinality on
+// analyzed text.
+var facetableFields = map[string]string{
+	"provider_name":        "provider_name",
+	"quantization":         "quantization",
+	"tag":                  "tag",
+	"tokenizer":            "tokenizer",
+	"input_modalities":     "input_modalities",
+	"output_modalities":    "output_modalities",
+	"supported_parameters": "supported_parameters",
+}
+
+// Repository runs full-text search and faceted aggregation queries
+// against the index a Client/Indexer pair maintains.
+type Repository struct {
+	client *Client
+}
+
+// NewRepository constructs a Repository reading through client.
+func NewRepository(client *Client) *Repository {
+	return &Repository{client: client}
+}
+
+// SearchModels runs q as a multi-field full-text query, narrowed by
+// filter, and returns up to limit hits alongside a terms aggregation for
+// each name in facets that facetableFields recognizes (unknown facet
+// names are silently ignored, since they're caller-supplied and a typo
+// shouldn't fail the whole search).
+func (r *Repository) SearchModels(ctx context.Context, q string, filter SearchFilter, facets []string, limit int) (*SearchResult, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+
+	body, err := buildSearchBody(q, filter, facets, limit)
+	if err != nil {
+		return nil, fmt.Errorf("build search query: %w", err)
+	}
+
+	var raw esSearchResponse
+	if err := r.client.Search(ctx, body, &raw); err != nil {
+		return nil, err
+	}
+
+	result := &SearchResult{
+		Total: raw.Hits.Total.Value,
+		Hits:  make([]Document, 0, len(raw.Hits.Hits)),
+	}
+	for _, hit := range raw.Hits.Hits {
+		result.Hits = append(result.Hits, hit.Source)
+	}
+
+	if len(raw.Aggregations) > 0 {
+		result.Facets = make(map[string][]Facet, len(raw.Aggregations))
+		for name, agg := range raw.Aggregations {
+			buckets := make([]Facet, 0, len(agg.Buckets))
+			for _, b := range agg.Buckets {
+				buckets = append(buckets, Facet{Value: fmt.Sprintf("%v", b.Key), Count: b.DocCount})
+			}
+			result.Facets[name] = buckets
+		}
+	}
+	return result, nil
+}
+
+// buildSearchBody renders the Elasticsearch query-DSL body for q/filter,
+// with a terms aggregation per recognized facet name.
+func buildSearchBody(q string, filter SearchFilter, facets []string, limit int) ([]byte, error) {
+	must := []map[string]interface{}{}
+	if q != "" {
+		must = append(must, map[string]interface{}{
+			"multi_match": map[string]interface{}{
+				"query":  q,
+				"fields": []string{"author^2", "model_name^2", "display_name", "description"},
+			},
+		})
+	}
+
+	filterClauses := buildFilterClauses(filter)
+
+	query := map[string]interface{}{}
+	if len(must) == 0 && len(filterClauses) == 0 {
+		query["match_all"] = map[string]interface{}{}
+	} else {
+		boolQuery := map[string]interface{}{}
+		if len(must) > 0 {
+			boolQuery["must"] = must
+		}
+		if len(filterClauses) > 0 {
+			boolQuery["filter"] = filterClauses
+		}
+		query["bool"] = boolQuery
+	}
+
+	body := map[string]interface{}{
+		"size":  limit,
+		"query": query,
+	}
+
+	aggs := map[string]interface{}{}
+	for _, name := range facets {
+		field, ok := facetableFields[name]
+		if !ok {
+			continue
+		}
+		aggs[name] = map[string]interface{}{
+			"terms": map[string]interface{}{"field": field, "size": 50},
+		}
+	}
+	if len(aggs) > 0 {
+		body["aggs"] = aggs
+	}
+
+	return json.Marshal(body)
+}
+
+// buildFilterClauses translates filter's non-zero fields into term/range
+// query-DSL clauses, following the same one-clause-per-non-nil-field
+// shape ProviderRepository/ModelRepository's filteredQuery build for SQL.
+func buildFilterClauses(filter SearchFilter) []map[string]interface{} {
+	var clauses []map[string]interface{}
+
+	if len(filter.Providers) > 0 {
+		clauses = append(clauses, map[string]interface{}{
+			"terms": map[string]interface{}{"provider_name": filter.Providers},
+		})
+	}
+	if len(filter.Quantizations) > 0 {
+		clauses = append(clauses, map[string]interface{}{
+			"terms": map[string]interface{}{"quantization": filter.Quantizations},
+		})
+	}
+	if len(filter.InputModalities) > 0 {
+		clauses = append(clauses, map[string]interface{}{
+			"terms": map[string]interface{}{"input_modalities": filter.InputModalities},
+		})
+	}
+	if len(filter.OutputModalities) > 0 {
+		clauses = append(clauses, map[string]interface{}{
+			"terms": map[string]interface{}{"output_modalities": filter.OutputModalities},
+		})
+	}
+	if filter.MinContextLength != nil {
+		clauses = append(clauses, map[string]interface{}{
+			"range": map[string]interface{}{"context_length": map[string]interface{}{"gte": *filter.MinContextLength}},
+		})
+	}
+	if filter.MaxPromptCost != nil {
+		clauses = append(clauses, map[string]interface{}{
+			"range": map[string]interface{}{"prompt_cost_value": map[string]interface{}{"lte": *filter.MaxPromptCost}},
+		})
+	}
+
+	return clauses
+}
+
+// esSearchResponse is the slice of Elasticsearch's _search response body
+// this package reads: hits and any requested aggregations.
+type esSearchResponse struct {
+	Hits struct {
+		Total struct {
+			Value int64 `json:"value"`
+		} `json:"total"`
+		Hits []struct {
+			Source Document `json:"_source"`
+		} `json:"hits"`
+	} `json:"hits"`
+	Aggregations map[string]struct {
+		Buckets []struct {
+			Key      interface{} `json:"key"`
+			DocCount int64       `json:"doc_count"`
+		} `json:"buckets"`
+	} `json:"aggregations"`
+}
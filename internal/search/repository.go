@@ -0,0 +1,79 @@
+package search
+
+import (
+	"context"
+	"log"
+
+	"github.com/adaptive/adaptive-model-registry/internal/models"
+	"github.com/adaptive/adaptive-model-registry/internal/repository"
+)
+
+// IndexedModelRepository decorates a repository.ModelRepository, mirroring
+// every write into an Indexer so Elasticsearch stays in sync with
+// Postgres. Indexing failures are logged rather than returned: Postgres
+// is the source of truth, so a write that succeeded there should not
+// fail the request just because the search index lagged behind.
+type IndexedModelRepository struct {
+	repository.ModelRepository
+	indexer *Indexer
+}
+
+// NewIndexedModelRepository wraps repo so Upsert/SoftDelete/Restore also
+// update indexer.
+func NewIndexedModelRepository(repo repository.ModelRepository, indexer *Indexer) *IndexedModelRepository {
+	return &IndexedModelRepository{ModelRepository: repo, indexer: indexer}
+}
+
+func (r *IndexedModelRepository) Upsert(ctx context.Context, model *models.Model, opts ...repository.UpsertOptions) (*models.Model, error) {
+	result, err := r.ModelRepository.Upsert(ctx, model, opts...)
+	if err != nil {
+		return result, err
+	}
+
+	for _, doc := range DocumentsForModel(*result) {
+		if err := r.indexer.Index(ctx, doc); err != nil {
+			log.Printf("search: index %s/%s endpoint %d failed: %v", result.Author, result.ModelName, doc.EndpointID, err)
+		}
+	}
+	return result, nil
+}
+
+func (r *IndexedModelRepository) SoftDelete(ctx context.Context, provider, name string) error {
+	existing, lookupErr := r.ModelRepository.GetByProviderAndName(ctx, provider, name)
+
+	if err := r.ModelRepository.SoftDelete(ctx, provider, name); err != nil {
+		return err
+	}
+
+	if lookupErr != nil {
+		log.Printf("search: could not resolve %s/%s's endpoints to remove from index: %v", provider, name, lookupErr)
+		return nil
+	}
+	for _, doc := range DocumentsForModel(*existing) {
+		if err := r.indexer.Delete(ctx, doc.ID); err != nil {
+			log.Printf("search: delete %s/%s endpoint %d from index failed: %v", provider, name, doc.EndpointID, err)
+		}
+	}
+	return nil
+}
+
+func (r *IndexedModelRepository) Restore(ctx context.Context, provider, name string) (*models.Model, error) {
+	result, err := r.ModelRepository.Restore(ctx, provider, name)
+	if err != nil {
+		return result, err
+	}
+
+	for _, doc := range DocumentsForModel(*result) {
+		if err := r.indexer.Index(ctx, doc); err != nil {
+			log.Printf("search: re-index %s/%s endpoint %d failed: %v", result.Author, result.ModelName, doc.EndpointID, err)
+		}
+	}
+	return result, nil
+}
+
+// UpdateEndpointHealth and UpdateEndpointHealthBatch are deliberately not
+// overridden: health.Scheduler records every endpoint's probe outcome
+// through UpdateEndpointHealthBatch once per probe interval, and
+// re-indexing that often would dwarf the bulk processor's intended write
+// volume for a field (status) facet/search queries rarely key on. Status
+// in the index reflects the endpoint's state as of its last Upsert/Restore.
@@ -0,0 +1,21 @@
+package search
+
+import "strconv"
+
+func itoa(v int64) string {
+	return strconv.FormatInt(v, 10)
+}
+
+// parseCost parses a decimal cost string like "0.0000015" into a float64
+// for aggregation purposes; a malformed or empty value contributes 0
+// rather than failing the whole document.
+func parseCost(s string) float64 {
+	if s == "" {
+		return 0
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
+	}
+	return v
+}
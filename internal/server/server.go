@@ -8,7 +8,9 @@ import (
 
 	"github.com/adaptive/adaptive-model-registry/internal/api"
 	"github.com/adaptive/adaptive-model-registry/internal/config"
+	"github.com/adaptive/adaptive-model-registry/internal/ratelimit"
 	"github.com/adaptive/adaptive-model-registry/internal/services"
+	"github.com/adaptive/adaptive-model-registry/internal/sync"
 )
 
 // Server wraps the Fiber application and related dependencies.
@@ -17,8 +19,11 @@ type Server struct {
 	app *fiber.App
 }
 
-// New constructs a Server instance with routes registered.
-func New(cfg config.Config, db *gorm.DB, modelService *services.ModelService, providerService *services.ProviderService) (*Server, error) {
+// New constructs a Server instance with routes registered. searchService
+// may be nil, which disables GET /search; limiter may be nil, which
+// disables rate limiting entirely; scheduler may be nil, which disables
+// the admin /admin/sync routes entirely.
+func New(cfg config.Config, db *gorm.DB, modelService *services.ModelService, providerService *services.ProviderService, searchService *services.SearchService, limiter ratelimit.RateLimiter, scheduler *sync.Scheduler) (*Server, error) {
 	app := fiber.New(fiber.Config{
 		Immutable:            true,
 		CaseSensitive:        true,
@@ -35,10 +40,13 @@ func New(cfg config.Config, db *gorm.DB, modelService *services.ModelService, pr
 	})
 
 	api.Register(app, api.Deps{
-		Config:    cfg,
-		DB:        db,
-		Models:    modelService,
-		Providers: providerService,
+		Config:      cfg,
+		DB:          db,
+		Models:      modelService,
+		Providers:   providerService,
+		Search:      searchService,
+		RateLimiter: limiter,
+		Sync:        scheduler,
 	})
 
 	return &Server{
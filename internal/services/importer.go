@@ -0,0 +1,44 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/adaptive/adaptive-model-registry/internal/models"
+)
+
+// Importer converts a raw external catalog payload (an OpenRouter-style
+// JSON dump, a LiteLLM pricing table, etc.) into the registry's
+// normalized Model tree, ready for ModelService.Upsert.
+type Importer interface {
+	// Name identifies the adapter for logging and admin surfaces.
+	Name() string
+	// Import parses data into zero or more models.Model.
+	Import(data []byte) ([]models.Model, error)
+}
+
+// NewImporter resolves the Importer for a catalog format name (as set via
+// IMPORT_FORMAT), defaulting to OpenRouterAdapter for an unrecognized or
+// empty name.
+func NewImporter(format string) Importer {
+	if format == "litellm" {
+		return NewLiteLLMAdapter()
+	}
+	return NewOpenRouterAdapter()
+}
+
+// defaultParametersToDB converts the loosely typed wire representation of
+// default parameters into the strongly typed DB column, via a JSON
+// round-trip so unknown keys are simply dropped rather than rejected.
+func defaultParametersToDB(raw models.DefaultParameters) (models.DefaultParametersValues, error) {
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return models.DefaultParametersValues{}, fmt.Errorf("import: marshal default parameters: %w", err)
+	}
+
+	var values models.DefaultParametersValues
+	if err := json.Unmarshal(b, &values); err != nil {
+		return models.DefaultParametersValues{}, fmt.Errorf("import: unmarshal default parameters: %w", err)
+	}
+	return values, nil
+}
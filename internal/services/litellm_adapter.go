@@ -0,0 +1,116 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/adaptive/adaptive-model-registry/internal/models"
+)
+
+// litellmEntry mirrors one value of LiteLLM's model_prices_and_context_window
+// catalog, which is a map keyed by model id rather than the array-of-objects
+// shape OpenRouter uses.
+type litellmEntry struct {
+	MaxTokens          *int    `json:"max_tokens"`
+	MaxInputTokens     *int    `json:"max_input_tokens"`
+	InputCostPerToken  float64 `json:"input_cost_per_token"`
+	OutputCostPerToken float64 `json:"output_cost_per_token"`
+	LiteLLMProvider    string  `json:"litellm_provider"`
+}
+
+// litellmSampleSpecKey is a documentation-only placeholder entry LiteLLM
+// ships at the top of its catalog; it isn't a real model and is skipped.
+const litellmSampleSpecKey = "sample_spec"
+
+// LiteLLMAdapter imports LiteLLM-style pricing table dumps
+// (model_prices_and_context_window.json).
+type LiteLLMAdapter struct{}
+
+// NewLiteLLMAdapter constructs a LiteLLMAdapter.
+func NewLiteLLMAdapter() *LiteLLMAdapter {
+	return &LiteLLMAdapter{}
+}
+
+func (*LiteLLMAdapter) Name() string { return "litellm" }
+
+func (*LiteLLMAdapter) Import(data []byte) ([]models.Model, error) {
+	var raw map[string]litellmEntry
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("litellm import: %w", err)
+	}
+
+	items := make([]models.Model, 0, len(raw))
+	for id, entry := range raw {
+		if id == litellmSampleSpecKey {
+			continue
+		}
+		items = append(items, litellmEntryToDB(id, entry))
+	}
+	return items, nil
+}
+
+func litellmEntryToDB(id string, e litellmEntry) models.Model {
+	author, name := splitLiteLLMID(id, e.LiteLLMProvider)
+	promptCost := strconv.FormatFloat(e.InputCostPerToken, 'f', -1, 64)
+	completionCost := strconv.FormatFloat(e.OutputCostPerToken, 'f', -1, 64)
+
+	m := models.Model{
+		Author:    author,
+		ModelName: name,
+		Pricing: &models.ModelPricing{
+			PromptCost:     promptCost,
+			CompletionCost: completionCost,
+		},
+	}
+
+	switch {
+	case e.MaxTokens != nil:
+		m.ContextLength = *e.MaxTokens
+	case e.MaxInputTokens != nil:
+		m.ContextLength = *e.MaxInputTokens
+	}
+
+	m.Providers = []models.ModelEndpoint{litellmEndpointToDB(id, e, author, m.ContextLength, promptCost, completionCost)}
+
+	return m
+}
+
+// litellmEndpointToDB builds the single ModelEndpoint a litellmEntry maps
+// to, using litellm_provider as provider_name the way it's already used as
+// the author fallback in splitLiteLLMID, falling back to the resolved
+// author itself when litellm_provider is empty. promptCost/completionCost
+// are passed in rather than recomputed so the endpoint-level and
+// model-level pricing this entry produces can never drift apart.
+func litellmEndpointToDB(id string, e litellmEntry, author string, contextLength int, promptCost, completionCost string) models.ModelEndpoint {
+	providerName := e.LiteLLMProvider
+	if providerName == "" {
+		providerName = author
+	}
+
+	return models.ModelEndpoint{
+		ProviderName:      providerName,
+		Tag:               "default",
+		Name:              id,
+		EndpointModelName: id,
+		ContextLength:     contextLength,
+		Pricing: &models.ModelEndpointPricing{
+			PromptCost:     promptCost,
+			CompletionCost: completionCost,
+		},
+	}
+}
+
+// splitLiteLLMID splits a LiteLLM model id into (author, name). Most keys
+// are "provider/model", but some ship bare (e.g. "gpt-4"), in which case
+// litellm_provider fills in for author.
+func splitLiteLLMID(id, provider string) (author, name string) {
+	if a, n, ok := strings.Cut(id, "/"); ok {
+		return a, n
+	}
+	if provider != "" {
+		return provider, id
+	}
+	return "unknown", id
+}
@@ -0,0 +1,177 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/adaptive/adaptive-model-registry/internal/models"
+	"github.com/adaptive/adaptive-model-registry/internal/repository"
+)
+
+// CheckReport summarizes problems found in a proposed model payload
+// without having written anything to the database. Valid is false
+// whenever any of the problem lists below (every slice field except
+// ConflictDiff) is non-empty; Conflict and ConflictDiff are
+// informational and do not affect Valid.
+type CheckReport struct {
+	Valid                      bool     `json:"valid"`
+	UnknownSupportedParameters []string `json:"unknown_supported_parameters,omitempty"`
+	UnknownDefaultParameters   []string `json:"unknown_default_parameters,omitempty"`
+	DuplicateEndpoints         []string `json:"duplicate_endpoints,omitempty"`
+	MissingPricingFields       []string `json:"missing_pricing_fields,omitempty"`
+	DisallowedModalities       []string `json:"disallowed_modalities,omitempty"`
+	Conflict                   bool     `json:"conflict"`
+	ConflictDiff               []string `json:"conflict_diff,omitempty"`
+}
+
+// Check validates model against the same shape Upsert expects, but never
+// writes anything: it's meant for CI pipelines and the sync script to
+// catch schema/data mistakes up front, and for operators to preview what
+// an Upsert would actually change.
+func (s *ModelService) Check(ctx context.Context, model *models.Model) (*CheckReport, error) {
+	report := &CheckReport{}
+
+	for _, p := range model.SupportedParameters {
+		if !models.IsValidSupportedParameter(string(p.ParameterName)) {
+			report.UnknownSupportedParameters = append(report.UnknownSupportedParameters, string(p.ParameterName))
+		}
+	}
+
+	if model.DefaultParameters != nil {
+		report.UnknownDefaultParameters = unknownDefaultParameterNames(model.DefaultParameters.Parameters)
+	}
+
+	report.DuplicateEndpoints = duplicateEndpoints(model.Providers)
+
+	if model.Pricing != nil {
+		report.MissingPricingFields = missingPricingFields(*model.Pricing)
+	}
+
+	if len(s.allowedModalities) > 0 && model.Architecture != nil {
+		report.DisallowedModalities = disallowedModalities(model.Architecture.Modalities, s.allowedModalities)
+	}
+
+	// Bypass the read cache: Check exists to report the database's current
+	// state, and a stale conflict/diff would defeat the point of a preview.
+	existing, err := s.repo.GetByProviderAndName(repository.WithNoCache(ctx), model.Author, model.ModelName)
+	switch {
+	case err == nil:
+		report.Conflict = true
+		report.ConflictDiff = diffSummary(*existing, *model)
+	case !errors.Is(err, repository.ErrNotFound):
+		return nil, err
+	}
+
+	report.Valid = len(report.UnknownSupportedParameters) == 0 &&
+		len(report.UnknownDefaultParameters) == 0 &&
+		len(report.DuplicateEndpoints) == 0 &&
+		len(report.MissingPricingFields) == 0 &&
+		len(report.DisallowedModalities) == 0
+
+	return report, nil
+}
+
+// unknownDefaultParameterNames reports the JSON names of every non-nil
+// field in values whose name isn't in models.DefaultParametersList. Since
+// DefaultParametersValues is strongly typed, an unrecognized key in the
+// request body is simply dropped by encoding/json before it ever reaches
+// here; this instead catches fields the struct does support but that
+// aren't (yet) accepted as defaults, e.g. top_k or max_tokens.
+func unknownDefaultParameterNames(values models.DefaultParametersValues) []string {
+	var unknown []string
+	v := reflect.ValueOf(values)
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		fv := v.Field(i)
+		if fv.Kind() != reflect.Ptr || fv.IsNil() {
+			continue
+		}
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		if !models.IsValidDefaultParameter(name) {
+			unknown = append(unknown, name)
+		}
+	}
+	return unknown
+}
+
+// duplicateEndpoints reports the (provider_name, tag) keys that appear on
+// more than one endpoint in endpoints.
+func duplicateEndpoints(endpoints []models.ModelEndpoint) []string {
+	seen := make(map[string]bool, len(endpoints))
+	var duplicates []string
+	for _, e := range endpoints {
+		key := fmt.Sprintf("%s/%s", e.ProviderName, e.Tag)
+		if seen[key] {
+			duplicates = append(duplicates, key)
+			continue
+		}
+		seen[key] = true
+	}
+	return duplicates
+}
+
+// missingPricingFields reports which required ModelPricing fields are
+// empty. PromptCost and CompletionCost are the only ones without
+// `omitzero`, i.e. the only ones Upsert treats as required.
+func missingPricingFields(pricing models.ModelPricing) []string {
+	var missing []string
+	if pricing.PromptCost == "" {
+		missing = append(missing, "prompt_cost")
+	}
+	if pricing.CompletionCost == "" {
+		missing = append(missing, "completion_cost")
+	}
+	return missing
+}
+
+// disallowedModalities reports modality values not present in allowed.
+func disallowedModalities(modalities []models.ModelArchitectureModality, allowed []string) []string {
+	allowedSet := make(map[string]bool, len(allowed))
+	for _, a := range allowed {
+		allowedSet[a] = true
+	}
+	var disallowed []string
+	for _, m := range modalities {
+		if !allowedSet[m.ModalityValue] {
+			disallowed = append(disallowed, m.ModalityValue)
+		}
+	}
+	return disallowed
+}
+
+// diffSummary describes the fields that differ between the stored model
+// and the incoming one, for display alongside Conflict.
+func diffSummary(existing, incoming models.Model) []string {
+	var diffs []string
+	if existing.DisplayName != incoming.DisplayName {
+		diffs = append(diffs, fmt.Sprintf("display_name: %q -> %q", existing.DisplayName, incoming.DisplayName))
+	}
+	if existing.Description != incoming.Description {
+		diffs = append(diffs, fmt.Sprintf("description: %q -> %q", existing.Description, incoming.Description))
+	}
+	if existing.ContextLength != incoming.ContextLength {
+		diffs = append(diffs, fmt.Sprintf("context_length: %d -> %d", existing.ContextLength, incoming.ContextLength))
+	}
+
+	var existingPricing, incomingPricing models.ModelPricing
+	if existing.Pricing != nil {
+		existingPricing = *existing.Pricing
+	}
+	if incoming.Pricing != nil {
+		incomingPricing = *incoming.Pricing
+	}
+	if existingPricing.PromptCost != incomingPricing.PromptCost {
+		diffs = append(diffs, fmt.Sprintf("pricing.prompt_cost: %q -> %q", existingPricing.PromptCost, incomingPricing.PromptCost))
+	}
+	if existingPricing.CompletionCost != incomingPricing.CompletionCost {
+		diffs = append(diffs, fmt.Sprintf("pricing.completion_cost: %q -> %q", existingPricing.CompletionCost, incomingPricing.CompletionCost))
+	}
+
+	return diffs
+}
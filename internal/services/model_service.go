@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"time"
 
 	"github.com/adaptive/adaptive-model-registry/internal/models"
 	"github.com/adaptive/adaptive-model-registry/internal/repository"
@@ -9,12 +10,15 @@ import (
 
 // ModelService orchestrates business logic around model metadata.
 type ModelService struct {
-	repo repository.ModelRepository
+	repo              repository.ModelRepository
+	allowedModalities []string
 }
 
-// NewModelService constructs a ModelService.
-func NewModelService(repo repository.ModelRepository) *ModelService {
-	return &ModelService{repo: repo}
+// NewModelService constructs a ModelService. allowedModalities is the
+// value space Check accepts for architecture modalities; a nil/empty
+// slice disables that check.
+func NewModelService(repo repository.ModelRepository, allowedModalities []string) *ModelService {
+	return &ModelService{repo: repo, allowedModalities: allowedModalities}
 }
 
 // List returns models matching the supplied filter ordered by name.
@@ -22,12 +26,62 @@ func (s *ModelService) List(ctx context.Context, filter models.ModelFilter) ([]m
 	return s.repo.List(ctx, filter)
 }
 
+// ListPage returns a single page of List's result for streaming consumers.
+func (s *ModelService) ListPage(ctx context.Context, filter models.ModelFilter, cursor *repository.StreamCursor, limit int) ([]models.Model, error) {
+	return s.repo.ListPage(ctx, filter, cursor, limit)
+}
+
+// EstimateCount returns an approximate row count for filter.
+func (s *ModelService) EstimateCount(ctx context.Context, filter models.ModelFilter) (int64, error) {
+	return s.repo.EstimateCount(ctx, filter)
+}
+
 // GetByProviderAndName retrieves a model by its provider and model name.
 func (s *ModelService) GetByProviderAndName(ctx context.Context, provider, name string) (*models.Model, error) {
 	return s.repo.GetByProviderAndName(ctx, provider, name)
 }
 
-// Upsert creates or updates a model entry.
-func (s *ModelService) Upsert(ctx context.Context, model *models.Model) (*models.Model, error) {
-	return s.repo.Upsert(ctx, model)
+// Upsert creates a new model, or reconciles an existing one's child
+// relationships against model per opts (full replace by default).
+func (s *ModelService) Upsert(ctx context.Context, model *models.Model, opts ...repository.UpsertOptions) (*models.Model, error) {
+	return s.repo.Upsert(ctx, model, opts...)
+}
+
+// Delete soft-deletes the model identified by provider/name.
+func (s *ModelService) Delete(ctx context.Context, provider, name string) error {
+	return s.repo.SoftDelete(ctx, provider, name)
+}
+
+// Restore un-deletes a previously soft-deleted model.
+func (s *ModelService) Restore(ctx context.Context, provider, name string) (*models.Model, error) {
+	return s.repo.Restore(ctx, provider, name)
+}
+
+// History returns the model identified by provider/name as it existed at
+// instant at.
+func (s *ModelService) History(ctx context.Context, provider, name string, at time.Time) (*models.Model, error) {
+	return s.repo.History(ctx, provider, name, at)
+}
+
+// ListEndpointsForProbe returns every non-deleted endpoint across all
+// models, for the health Scheduler to probe.
+func (s *ModelService) ListEndpointsForProbe(ctx context.Context) ([]models.ModelEndpoint, error) {
+	return s.repo.ListEndpoints(ctx)
+}
+
+// RecordEndpointHealthBatch stamps the outcome of an entire probe cycle's
+// worth of endpoints in one call, so a cache-backed repository purges
+// once for the batch instead of once per endpoint.
+func (s *ModelService) RecordEndpointHealthBatch(ctx context.Context, updates []repository.EndpointHealthUpdate) error {
+	return s.repo.UpdateEndpointHealthBatch(ctx, updates)
+}
+
+// CacheStats reports cache hit/miss/evict counters when the underlying
+// repository is cache-backed, and ok=false when caching is disabled.
+func (s *ModelService) CacheStats() (stats repository.CacheStats, ok bool) {
+	statter, ok := s.repo.(repository.CacheStatter)
+	if !ok {
+		return repository.CacheStats{}, false
+	}
+	return statter.Stats(), true
 }
@@ -0,0 +1,175 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/adaptive/adaptive-model-registry/internal/models"
+)
+
+// openRouterModel mirrors one entry of OpenRouter's GET /api/v1/models
+// response. The field shapes deliberately match models.Pricing,
+// models.Architecture, and models.TopProvider, since those API-facing
+// types were modeled on this catalog format.
+type openRouterModel struct {
+	ID                  string                   `json:"id"` // "author/model-name"
+	Name                string                   `json:"name"`
+	Description         string                   `json:"description"`
+	ContextLength       int                      `json:"context_length"`
+	Architecture        models.Architecture      `json:"architecture"`
+	TopProvider         models.TopProvider       `json:"top_provider"`
+	Pricing             models.Pricing           `json:"pricing"`
+	SupportedParameters []string                 `json:"supported_parameters"`
+	DefaultParameters   models.DefaultParameters `json:"default_parameters"`
+}
+
+type openRouterResponse struct {
+	Data []openRouterModel `json:"data"`
+}
+
+// OpenRouterAdapter imports OpenRouter-style catalog dumps.
+type OpenRouterAdapter struct{}
+
+// NewOpenRouterAdapter constructs an OpenRouterAdapter.
+func NewOpenRouterAdapter() *OpenRouterAdapter {
+	return &OpenRouterAdapter{}
+}
+
+func (*OpenRouterAdapter) Name() string { return "openrouter" }
+
+func (*OpenRouterAdapter) Import(data []byte) ([]models.Model, error) {
+	var resp openRouterResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("openrouter import: %w", err)
+	}
+
+	items := make([]models.Model, 0, len(resp.Data))
+	for _, raw := range resp.Data {
+		m, err := openRouterModelToDB(raw)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, m)
+	}
+	return items, nil
+}
+
+func openRouterModelToDB(raw openRouterModel) (models.Model, error) {
+	author, name, ok := strings.Cut(raw.ID, "/")
+	if !ok {
+		return models.Model{}, fmt.Errorf("openrouter import: model id %q missing author/name separator", raw.ID)
+	}
+
+	m := models.Model{
+		Author:        author,
+		ModelName:     name,
+		DisplayName:   raw.Name,
+		Description:   raw.Description,
+		ContextLength: raw.ContextLength,
+		Pricing:       openRouterPricingToDB(raw.Pricing),
+		Architecture:  openRouterArchitectureToDB(raw.Architecture),
+		TopProvider:   openRouterTopProviderToDB(raw.TopProvider),
+		Providers:     []models.ModelEndpoint{openRouterEndpointToDB(raw)},
+	}
+
+	for _, p := range raw.SupportedParameters {
+		if !models.IsValidSupportedParameter(p) {
+			continue
+		}
+		m.SupportedParameters = append(m.SupportedParameters, models.ModelSupportedParameter{
+			ParameterName: models.SupportedParameter(p),
+		})
+	}
+
+	if len(raw.DefaultParameters) > 0 {
+		values, err := defaultParametersToDB(raw.DefaultParameters)
+		if err != nil {
+			return models.Model{}, err
+		}
+		m.DefaultParameters = &models.ModelDefaultParameters{Parameters: values}
+	}
+
+	return m, nil
+}
+
+func openRouterPricingToDB(p models.Pricing) *models.ModelPricing {
+	db := &models.ModelPricing{
+		PromptCost:     p.Prompt,
+		CompletionCost: p.Completion,
+	}
+	if p.Request != nil {
+		db.RequestCost = *p.Request
+	}
+	if p.Image != nil {
+		db.ImageCost = *p.Image
+	}
+	if p.WebSearch != nil {
+		db.WebSearchCost = *p.WebSearch
+	}
+	if p.InternalReasoning != nil {
+		db.InternalReasoningCost = *p.InternalReasoning
+	}
+	return db
+}
+
+func openRouterArchitectureToDB(a models.Architecture) *models.ModelArchitecture {
+	arch := &models.ModelArchitecture{
+		Modality:  a.Modality,
+		Tokenizer: a.Tokenizer,
+	}
+	if a.InstructType != nil {
+		arch.InstructType = *a.InstructType
+	}
+	for _, v := range a.InputModalities {
+		arch.Modalities = append(arch.Modalities, models.ModelArchitectureModality{ModalityType: "input", ModalityValue: v})
+	}
+	for _, v := range a.OutputModalities {
+		arch.Modalities = append(arch.Modalities, models.ModelArchitectureModality{ModalityType: "output", ModalityValue: v})
+	}
+	return arch
+}
+
+func openRouterTopProviderToDB(t models.TopProvider) *models.ModelTopProvider {
+	return &models.ModelTopProvider{
+		ContextLength:       t.ContextLength,
+		MaxCompletionTokens: t.MaxCompletionTokens,
+		IsModerated:         strconv.FormatBool(t.IsModerated),
+	}
+}
+
+// openRouterEndpointToDB builds the single ModelEndpoint every OpenRouter
+// catalog entry maps to: OpenRouter serves every model itself, under
+// provider_name "openrouter", so there's exactly one endpoint per entry
+// rather than one per upstream provider.
+func openRouterEndpointToDB(raw openRouterModel) models.ModelEndpoint {
+	return models.ModelEndpoint{
+		ProviderName:      "openrouter",
+		Tag:               "default",
+		Name:              raw.ID,
+		EndpointModelName: raw.ID,
+		ContextLength:     raw.ContextLength,
+		Pricing:           openRouterEndpointPricingToDB(raw.Pricing),
+	}
+}
+
+func openRouterEndpointPricingToDB(p models.Pricing) *models.ModelEndpointPricing {
+	db := &models.ModelEndpointPricing{
+		PromptCost:     p.Prompt,
+		CompletionCost: p.Completion,
+	}
+	if p.Request != nil {
+		db.RequestCost = *p.Request
+	}
+	if p.Image != nil {
+		db.ImageCost = *p.Image
+	}
+	if p.ImageOutput != nil {
+		db.ImageOutputCost = *p.ImageOutput
+	}
+	if p.Discount != nil {
+		db.Discount = strconv.Itoa(*p.Discount)
+	}
+	return db
+}
@@ -21,3 +21,8 @@ func NewProviderService(repo repository.ProviderRepository) *ProviderService {
 func (s *ProviderService) List(ctx context.Context, filter models.ProviderFilter) ([]models.Provider, error) {
 	return s.repo.List(ctx, filter)
 }
+
+// ListPage returns a single page of List's result for paginated consumers.
+func (s *ProviderService) ListPage(ctx context.Context, filter models.ProviderFilter, cursor string, limit int) ([]models.Provider, error) {
+	return s.repo.ListPage(ctx, filter, cursor, limit)
+}
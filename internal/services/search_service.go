@@ -0,0 +1,24 @@
+package services
+
+import (
+	"context"
+
+	"github.com/adaptive/adaptive-model-registry/internal/search"
+)
+
+// SearchService orchestrates business logic around full-text search,
+// the same role ModelService/ProviderService play for their repositories.
+type SearchService struct {
+	repo *search.Repository
+}
+
+// NewSearchService constructs a SearchService backed by repo.
+func NewSearchService(repo *search.Repository) *SearchService {
+	return &SearchService{repo: repo}
+}
+
+// SearchModels runs q against the index, narrowed by filter, returning
+// up to limit hits plus any requested facets.
+func (s *SearchService) SearchModels(ctx context.Context, q string, filter search.SearchFilter, facets []string, limit int) (*search.SearchResult, error) {
+	return s.repo.SearchModels(ctx, q, filter, facets, limit)
+}
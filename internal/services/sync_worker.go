@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/adaptive/adaptive-model-registry/internal/repository"
+)
+
+// SyncWorker periodically pulls a catalog dump from a configured URL
+// through an Importer and upserts the result into a ModelService, keeping
+// the registry aligned with an upstream catalog without a manual
+// POST /models/bulk call.
+type SyncWorker struct {
+	importer  Importer
+	models    *ModelService
+	sourceURL string
+	interval  time.Duration
+	client    *http.Client
+}
+
+// NewSyncWorker constructs a SyncWorker that pulls sourceURL every
+// interval. Callers should only start Run when sourceURL is non-empty.
+func NewSyncWorker(importer Importer, modelService *ModelService, sourceURL string, interval time.Duration) *SyncWorker {
+	return &SyncWorker{
+		importer:  importer,
+		models:    modelService,
+		sourceURL: sourceURL,
+		interval:  interval,
+		client:    &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Run syncs once immediately and then every w.interval, until ctx is
+// canceled. An interval <= 0 disables the periodic re-sync; Run returns
+// after the initial sync.
+func (w *SyncWorker) Run(ctx context.Context) {
+	if err := w.syncOnce(ctx); err != nil {
+		log.Printf("sync: initial %s import failed: %v", w.importer.Name(), err)
+	}
+
+	if w.interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.syncOnce(ctx); err != nil {
+				log.Printf("sync: %s import failed: %v", w.importer.Name(), err)
+			}
+		}
+	}
+}
+
+func (w *SyncWorker) syncOnce(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, w.sourceURL, nil)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("fetch %s: %w", w.sourceURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("fetch %s: unexpected status %s", w.sourceURL, resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("read response: %w", err)
+	}
+
+	items, err := w.importer.Import(body)
+	if err != nil {
+		return fmt.Errorf("%s import: %w", w.importer.Name(), err)
+	}
+
+	// PreserveEndpointHealth: items never carry a real probe result, so a
+	// periodic resync shouldn't overwrite whatever the health Scheduler
+	// already recorded for an existing endpoint.
+	opts := repository.UpsertOptions{PreserveEndpointHealth: true}
+
+	var firstErr error
+	for i := range items {
+		if _, err := w.models.Upsert(ctx, &items[i], opts); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("upsert %s/%s: %w", items[i].Author, items[i].ModelName, err)
+		}
+	}
+	return firstErr
+}
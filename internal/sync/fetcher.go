@@ -0,0 +1,56 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Fetcher retrieves one source's raw catalog payload, ready to be handed
+// to a services.Importer. Separating it from Importer (which only
+// parses) is what lets a Source swap in a non-HTTP transport later
+// without touching its parsing logic.
+type Fetcher interface {
+	Fetch(ctx context.Context) ([]byte, error)
+}
+
+// HTTPFetcher fetches a catalog dump from a URL. Unlike
+// services.SyncWorker's client, it carries no fixed client-level
+// timeout: a Source's configured Timeout already bounds the request
+// through ctx (see Scheduler.run), and a second, shorter timeout here
+// would silently override it for any source configured with a longer
+// one.
+type HTTPFetcher struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPFetcher constructs an HTTPFetcher for url.
+func NewHTTPFetcher(url string) *HTTPFetcher {
+	return &HTTPFetcher{url: url, client: &http.Client{}}
+}
+
+// Fetch issues a GET against f.url, honoring ctx's deadline.
+func (f *HTTPFetcher) Fetch(ctx context.Context) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("build request: %w", err)
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch %s: %w", f.url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch %s: unexpected status %s", f.url, resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+	return body, nil
+}
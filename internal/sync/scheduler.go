@@ -0,0 +1,259 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/adaptive/adaptive-model-registry/internal/models"
+	"github.com/adaptive/adaptive-model-registry/internal/repository"
+	"github.com/adaptive/adaptive-model-registry/internal/services"
+)
+
+// ErrUnknownSource is returned by TriggerNow/Pause/Resume when asked
+// about a source name Scheduler wasn't configured with, so the admin API
+// can distinguish "no such source" (404) from a sync run that failed for
+// an existing one (500).
+var ErrUnknownSource = errors.New("unknown sync source")
+
+// sourceState bundles a Source's static configuration with its mutable
+// runtime state, guarded by Scheduler.mu.
+type sourceState struct {
+	source Source
+	status SourceStatus
+}
+
+// Scheduler runs each configured Source on its own cron schedule,
+// upserting whatever it fetches through models. Unlike
+// services.SyncWorker, a failed run doesn't block or deschedule future
+// runs of other sources — they're independent in scheduling, retries,
+// and pause state.
+type Scheduler struct {
+	cron   *cron.Cron
+	models *services.ModelService
+
+	mu      sync.Mutex
+	sources map[string]*sourceState
+	ctx     context.Context
+}
+
+// NewScheduler constructs a Scheduler for sources, registering each
+// one's cron schedule. It returns an error if any Schedule fails to
+// parse.
+func NewScheduler(models *services.ModelService, sources []Source) (*Scheduler, error) {
+	s := &Scheduler{
+		cron:    cron.New(),
+		models:  models,
+		sources: make(map[string]*sourceState, len(sources)),
+		ctx:     context.Background(),
+	}
+
+	for _, src := range sources {
+		if _, exists := s.sources[src.Name]; exists {
+			return nil, fmt.Errorf("duplicate sync source name %q", src.Name)
+		}
+		state := &sourceState{source: src, status: SourceStatus{Name: src.Name}}
+		s.sources[src.Name] = state
+
+		name := src.Name
+		if _, err := s.cron.AddFunc(src.Schedule, func() { s.runScheduled(name) }); err != nil {
+			return nil, fmt.Errorf("schedule %q: %w", src.Name, err)
+		}
+	}
+
+	return s, nil
+}
+
+// Run starts the cron scheduler, running each source on its own
+// schedule until ctx is canceled. It's the Run(ctx context.Context)
+// shape services.SyncWorker/health.Scheduler use, with the actual
+// ticking delegated to the cron library instead of a single
+// time.Ticker.
+func (s *Scheduler) Run(ctx context.Context) {
+	s.mu.Lock()
+	s.ctx = ctx
+	s.mu.Unlock()
+
+	s.cron.Start()
+	<-ctx.Done()
+	<-s.cron.Stop().Done()
+}
+
+// runScheduled is what each source's cron entry calls; it skips paused
+// sources and only logs failures, since a scheduled firing has no caller
+// waiting on its result.
+func (s *Scheduler) runScheduled(name string) {
+	s.mu.Lock()
+	state := s.sources[name]
+	ctx := s.ctx
+	paused := state.status.Paused
+	s.mu.Unlock()
+
+	if paused {
+		return
+	}
+	if err := s.run(ctx, state); err != nil {
+		log.Printf("sync: %s failed: %v", name, err)
+	}
+}
+
+// TriggerNow runs name immediately, ignoring both its schedule and its
+// pause state — an operator asking for a sync right now means right
+// now, even if the source is paused.
+func (s *Scheduler) TriggerNow(ctx context.Context, name string) error {
+	s.mu.Lock()
+	state, ok := s.sources[name]
+	s.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrUnknownSource, name)
+	}
+	return s.run(ctx, state)
+}
+
+// Pause stops name from running on its schedule until Resume is called.
+// An in-flight run is unaffected.
+func (s *Scheduler) Pause(name string) error {
+	return s.setPaused(name, true)
+}
+
+// Resume re-enables name's schedule after a Pause.
+func (s *Scheduler) Resume(name string) error {
+	return s.setPaused(name, false)
+}
+
+func (s *Scheduler) setPaused(name string, paused bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, ok := s.sources[name]
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrUnknownSource, name)
+	}
+	state.status.Paused = paused
+	return nil
+}
+
+// Status reports every configured source's current state, sorted by
+// name for a stable response body.
+func (s *Scheduler) Status() []SourceStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	statuses := make([]SourceStatus, 0, len(s.sources))
+	for _, state := range s.sources {
+		statuses = append(statuses, state.status)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses
+}
+
+// run fetches, imports, and upserts state.source once, retrying the
+// fetch and import stages with exponential backoff up to
+// state.source.MaxRetries times, and records the outcome on state.status.
+func (s *Scheduler) run(ctx context.Context, state *sourceState) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, state.source.Timeout)
+	defer cancel()
+
+	count, err := s.fetchAndUpsert(timeoutCtx, state.source)
+
+	s.mu.Lock()
+	state.status.LastRunAt = time.Now()
+	if err != nil {
+		state.status.LastError = err.Error()
+	} else {
+		state.status.LastError = ""
+		state.status.LastSuccessAt = state.status.LastRunAt
+		state.status.LastItemCount = count
+	}
+	s.mu.Unlock()
+
+	return err
+}
+
+// fetchAndUpsert retries the fetch+import stage with exponential backoff,
+// since those fail the same way a network blip would: retrying the exact
+// same request again is likely to eventually succeed. It deliberately
+// does NOT retry the upsert stage: a per-item upsert failure is almost
+// always a data problem with that one model (the same bad row every
+// time), so retrying the whole batch would just burn the backoff budget
+// re-fetching and re-importing good items to fail on the same one again.
+func (s *Scheduler) fetchAndUpsert(ctx context.Context, src Source) (int, error) {
+	items, err := s.fetchAndImport(ctx, src)
+	if err != nil {
+		return 0, err
+	}
+
+	// Each Upsert call is its own transaction covering the model plus its
+	// endpoints/pricing/architecture rows (ModelRepository.Upsert), so a
+	// failure partway through items only leaves the remaining ones stale,
+	// never a half-written row.
+	//
+	// Mode: UpsertMerge. Scheduler can run several sources against the same
+	// model (e.g. both an "openrouter" and a "litellm" source covering
+	// openai/gpt-4), and no single source's payload describes everything
+	// the model has - UpsertReplace would delete every relationship
+	// (endpoints, Architecture, TopProvider, SupportedParameters,
+	// DefaultParameters) the other source wrote, since neither payload
+	// mentions the other's contribution. The tradeoff: a source that
+	// genuinely drops something it used to report (e.g. a model loses a
+	// supported parameter upstream) leaves the stale row in place until a
+	// source that still omits it is reconciled some other way - the
+	// registry doesn't track which source owns which row, so "merge with
+	// the other sources" and "clean up after myself" aren't both
+	// satisfiable from one Mode. Multi-source correctness wins here since
+	// cross-source deletion is the more damaging failure mode.
+	//
+	// PreserveEndpointHealth: items never carry a real probe result, so a
+	// resync shouldn't overwrite whatever the health Scheduler already
+	// recorded for an existing endpoint.
+	opts := repository.UpsertOptions{Mode: repository.UpsertMerge, PreserveEndpointHealth: true}
+
+	var upsertErr error
+	for i := range items {
+		if _, err := s.models.Upsert(ctx, &items[i], opts); err != nil && upsertErr == nil {
+			upsertErr = fmt.Errorf("upsert %s/%s: %w", items[i].Author, items[i].ModelName, err)
+		}
+	}
+	if upsertErr != nil {
+		return 0, upsertErr
+	}
+
+	return len(items), nil
+}
+
+func (s *Scheduler) fetchAndImport(ctx context.Context, src Source) ([]models.Model, error) {
+	backoff := src.InitialBackoff
+	var lastErr error
+
+	for attempt := 0; attempt <= src.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+
+		data, err := src.Fetcher.Fetch(ctx)
+		if err != nil {
+			lastErr = fmt.Errorf("fetch: %w", err)
+			continue
+		}
+
+		items, err := src.Importer.Import(data)
+		if err != nil {
+			lastErr = fmt.Errorf("import: %w", err)
+			continue
+		}
+
+		return items, nil
+	}
+
+	return nil, lastErr
+}
@@ -0,0 +1,40 @@
+// Package sync periodically refreshes provider/endpoint metadata from
+// one or more upstream catalogs, each on its own cron schedule, going
+// through the same ModelService.Upsert every other write path uses (and
+// so the same per-model transaction in ModelRepository.Upsert, the same
+// cache purge when CachedModelRepository is in front, and the same
+// search re-index when search.IndexedModelRepository is in front).
+//
+// It's a generalization of services.SyncWorker: SyncWorker polls one
+// source on a fixed interval forever; Scheduler runs any number of
+// sources, each with its own cron expression, timeout, and retry
+// backoff, and can be paused/resumed/triggered individually through the
+// admin API in internal/api/sync.go.
+package sync
+
+import (
+	"time"
+
+	"github.com/adaptive/adaptive-model-registry/internal/services"
+)
+
+// Source configures one upstream catalog Scheduler pulls from.
+type Source struct {
+	// Name identifies the source in the admin API and in logs.
+	Name string
+	// Fetcher retrieves the raw catalog payload.
+	Fetcher Fetcher
+	// Importer parses the payload Fetcher returns into models.Model.
+	Importer services.Importer
+	// Schedule is a robfig/cron/v3 expression (standard 5-field cron, or
+	// a "@every 1h"-style descriptor).
+	Schedule string
+	// Timeout bounds a single fetch+import+upsert run.
+	Timeout time.Duration
+	// MaxRetries is how many additional fetch+import attempts a run gets
+	// before giving up; a failed upsert is not retried.
+	MaxRetries int
+	// InitialBackoff is the delay before the first retry; it doubles on
+	// each subsequent one, mirroring search.Indexer.Flush's backoff.
+	InitialBackoff time.Duration
+}
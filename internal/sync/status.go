@@ -0,0 +1,14 @@
+package sync
+
+import "time"
+
+// SourceStatus reports one source's current state and last-run outcome,
+// for the admin API's GET /admin/sync.
+type SourceStatus struct {
+	Name          string    `json:"name"`
+	Paused        bool      `json:"paused"`
+	LastRunAt     time.Time `json:"last_run_at,omitzero"`
+	LastSuccessAt time.Time `json:"last_success_at,omitzero"`
+	LastError     string    `json:"last_error,omitempty"`
+	LastItemCount int       `json:"last_item_count"`
+}